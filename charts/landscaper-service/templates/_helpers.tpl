@@ -139,6 +139,10 @@ landscaperServiceComponent:
   registryPullSecrets:
 {{ toYaml .Values.landscaperservice.landscaperServiceComponent.registryPullSecrets | indent 4 }}
 {{- end }}
+{{- if .Values.landscaperservice.landscaperServiceComponent.supportedVersions }}
+  supportedVersions:
+{{ toYaml .Values.landscaperservice.landscaperServiceComponent.supportedVersions | indent 4 }}
+{{- end }}
 
 availabilityMonitoring:
   availabilityCollectionName: {{ ((.Values.landscaperservice.availabilityMonitoring).availabilityCollectionName) | default "availability" }}
@@ -153,11 +157,40 @@ availabilityMonitoring:
     timeout: {{ .Values.landscaperservice.availabilityMonitoring.AVSConfiguration.timeout | default "30s" }}
   {{- end }}
 
+serviceTargetConfigProbe:
+  interval: {{ ((.Values.landscaperservice.serviceTargetConfigProbe).interval) | default "5m" }}
+  timeout: {{ ((.Values.landscaperservice.serviceTargetConfigProbe).timeout) | default "30s" }}
+
+upgrade:
+  maxConcurrentUpgrades: {{ ((.Values.landscaperservice.upgrade).maxConcurrentUpgrades) | default 1 }}
+  checkInterval: {{ ((.Values.landscaperservice.upgrade).checkInterval) | default "1m" }}
+  {{- if (.Values.landscaperservice.upgrade).defaultMaintenanceWindow }}
+  defaultMaintenanceWindow:
+{{ toYaml .Values.landscaperservice.upgrade.defaultMaintenanceWindow | indent 4 }}
+  {{- end }}
+
+credentialRotation:
+  tokenExpiration: {{ ((.Values.landscaperservice.credentialRotation).tokenExpiration) | default "2160h" }}
+  adminKubeconfigExpiration: {{ ((.Values.landscaperservice.credentialRotation).adminKubeconfigExpiration) | default "24h" }}
+
+{{- if .Values.landscaperservice.sidecarWebhooksServer }}
+sidecarWebhooksServer:
+{{ toYaml .Values.landscaperservice.sidecarWebhooksServer | indent 2 }}
+{{- end }}
+
 gardenerConfiguration:
 {{ toYaml .Values.landscaperservice.gardener | indent 2 }}
 
 shootConfiguration:
 {{ toYaml .Values.landscaperservice.shootConfiguration | indent 2 }}
+{{- if .Values.landscaperservice.shootRegionProfiles }}
+shootRegionProfiles:
+{{ toYaml .Values.landscaperservice.shootRegionProfiles | indent 2 }}
+{{- end }}
+{{- if .Values.landscaperservice.shootProfiles }}
+shootProfiles:
+{{ toYaml .Values.landscaperservice.shootProfiles | indent 2 }}
+{{- end }}
 
 {{- if .Values.landscaperservice.auditLogConfiguration }}
 auditLogConfig:
@@ -168,8 +201,44 @@ auditLogConfig:
     namespace: {{ .Release.Namespace }}
     key: policy
 {{- end }}
-{{- end }}
 
 scheduling:
   name: scheduling
   namespace: {{ .Release.Namespace }}
+{{- if .Values.landscaperservice.schedulingSelector }}
+schedulingSelector:
+{{ toYaml .Values.landscaperservice.schedulingSelector | indent 2 }}
+{{- end }}
+{{- end }}
+
+{{/*
+Comma-separated list of the landscaper service component versions which can be selected by landscaper deployments
+*/}}
+{{- define "landscaper-service.supportedVersions" -}}
+{{- $versions := list -}}
+{{- range .Values.landscaperservice.landscaperServiceComponent.supportedVersions -}}
+{{- $versions = append $versions .version -}}
+{{- end -}}
+{{- join "," $versions -}}
+{{- end }}
+
+{{/*
+Comma-separated list of the names of the shoot profiles which can be selected by landscaper deployments
+*/}}
+{{- define "landscaper-service.shootProfiles" -}}
+{{- $names := list -}}
+{{- range .Values.landscaperservice.shootProfiles -}}
+{{- if .kubernetesVersion -}}
+{{- $names = append $names (printf "%s=%s" .name .kubernetesVersion) -}}
+{{- else -}}
+{{- $names = append $names .name -}}
+{{- end -}}
+{{- end -}}
+{{- join "," $names -}}
+{{- end }}
+
+{{- define "landscaper-service-defaults" -}}
+apiVersion: config.landscaper-service.gardener.cloud/v1alpha1
+kind: LandscaperServiceDefaults
+{{ toYaml .Values.webhooksServer.defaults }}
+{{- end }}
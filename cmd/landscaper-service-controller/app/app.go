@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	lssinstall "github.com/gardener/landscaper-service/pkg/apis/core/install"
@@ -22,8 +23,13 @@ import (
 	"github.com/gardener/landscaper-service/pkg/controllers/healthwatcher"
 	instancesctrl "github.com/gardener/landscaper-service/pkg/controllers/instances"
 	landscaperdeploymentsctrl "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments"
+	rebalanceplansctrl "github.com/gardener/landscaper-service/pkg/controllers/rebalanceplans"
 	servicetargetconfigsctrl "github.com/gardener/landscaper-service/pkg/controllers/servicetargetconfigs"
+	targetschedulingsctrl "github.com/gardener/landscaper-service/pkg/controllers/targetschedulings"
+	tenantquotasctrl "github.com/gardener/landscaper-service/pkg/controllers/tenantquotas"
+	upgradectrl "github.com/gardener/landscaper-service/pkg/controllers/upgrade"
 	"github.com/gardener/landscaper-service/pkg/crdmanager"
+	lssmetrics "github.com/gardener/landscaper-service/pkg/metrics"
 	"github.com/gardener/landscaper-service/pkg/utils"
 	"github.com/gardener/landscaper-service/pkg/version"
 )
@@ -49,6 +55,8 @@ func NewLandscaperServiceControllerCommand(ctx context.Context) *cobra.Command {
 
 	options.AddFlags(cmd.Flags())
 
+	cmd.AddCommand(NewSimulateSchedulingCommand())
+
 	return cmd
 }
 
@@ -89,6 +97,15 @@ func (o *options) run(ctx context.Context) error {
 	if err := servicetargetconfigsctrl.AddControllerToManager(ctrlLogger, mgr, o.Config); err != nil {
 		return fmt.Errorf("unable to setup service target configs controller: %w", err)
 	}
+	if err := tenantquotasctrl.AddControllerToManager(ctrlLogger, mgr, o.Config); err != nil {
+		return fmt.Errorf("unable to setup tenant quotas controller: %w", err)
+	}
+	if err := targetschedulingsctrl.AddControllerToManager(ctrlLogger, mgr, o.Config); err != nil {
+		return fmt.Errorf("unable to setup target schedulings controller: %w", err)
+	}
+	if err := rebalanceplansctrl.AddControllerToManager(ctrlLogger, mgr, o.Config); err != nil {
+		return fmt.Errorf("unable to setup rebalance plans controller: %w", err)
+	}
 	if err := avmonitorregistration.AddControllerToManager(ctrlLogger, mgr, o.Config); err != nil {
 		return fmt.Errorf("unable to setup availabilitymonitorregistrationcontroller controller: %w", err)
 	}
@@ -98,6 +115,13 @@ func (o *options) run(ctx context.Context) error {
 	if err := avuploader.AddControllerToManager(ctrlLogger, mgr, o.Config); err != nil {
 		return fmt.Errorf("unable to setup avuploader controller: %w", err)
 	}
+	if err := upgradectrl.AddControllerToManager(ctrlLogger, mgr, o.Config); err != nil {
+		return fmt.Errorf("unable to setup upgrade controller: %w", err)
+	}
+
+	if err := ctrlmetrics.Registry.Register(lssmetrics.NewInventoryCollector(mgr.GetClient(), o.Log.WithName("metrics"))); err != nil {
+		return fmt.Errorf("unable to register inventory metrics: %w", err)
+	}
 
 	o.Log.Info("starting the controllers")
 	if err := mgr.Start(ctx); err != nil {
@@ -7,20 +7,29 @@ package app
 import (
 	"context"
 	goflag "flag"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
 	configinstall "github.com/gardener/landscaper-service/pkg/apis/config/install"
 	"github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	instancesctrl "github.com/gardener/landscaper-service/pkg/controllers/instances"
 
 	flag "github.com/spf13/pflag"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+// maxAdminKubeconfigExpiration is the maximum validity of the admin kubeconfig of a resource cluster, as accepted by gardener.
+const maxAdminKubeconfigExpiration = 24 * time.Hour
+
 // options holds the landscaper service controller options
 type options struct {
 	Log        logging.Logger // Log is the logger instance
@@ -82,5 +91,119 @@ func (o *options) parseConfigurationFile(ctx context.Context) (*v1alpha1.Landsca
 }
 
 func (o *options) validate() error {
+	if err := o.validateCredentialRotation(); err != nil {
+		return err
+	}
+	if err := o.validateSchedulingSelector(); err != nil {
+		return err
+	}
+	if err := o.validateShootRegionProfiles(); err != nil {
+		return err
+	}
+	if err := o.validateShootProfiles(); err != nil {
+		return err
+	}
+	return o.validateSupportedVersions()
+}
+
+// validateShootRegionProfiles validates the region specific shoot configurations.
+// There must be at most one profile per region and provider type.
+func (o *options) validateShootRegionProfiles() error {
+	profiles := map[string]bool{}
+	for i, profile := range o.Config.ShootRegionProfiles {
+		if len(profile.Region) == 0 {
+			return fmt.Errorf("shoot region profile %d has no region", i)
+		}
+		if len(profile.Provider.Type) == 0 || len(profile.Provider.Zone) == 0 {
+			return fmt.Errorf("shoot region profile of region %q must specify the provider type and zone", profile.Region)
+		}
+		key := profile.Region + "/" + profile.Provider.Type
+		if profiles[key] {
+			return fmt.Errorf("there is more than one shoot region profile of region %q and provider type %q", profile.Region, profile.Provider.Type)
+		}
+		profiles[key] = true
+	}
+	return nil
+}
+
+// validateSchedulingSelector validates the label selector for additional target schedulings.
+func (o *options) validateSchedulingSelector() error {
+	if o.Config.SchedulingSelector == nil {
+		return nil
+	}
+	if _, err := metav1.LabelSelectorAsSelector(o.Config.SchedulingSelector); err != nil {
+		return fmt.Errorf("scheduling selector is invalid: %w", err)
+	}
+	return nil
+}
+
+// validateCredentialRotation validates the expiration durations of the instance credentials.
+func (o *options) validateCredentialRotation() error {
+	rotationConfig := &o.Config.CredentialRotation
+	if rotationConfig.TokenExpiration.Duration <= instancesctrl.InstallationAutomaticReconcileInterval {
+		return fmt.Errorf("token expiration %s must be longer than the automatic reconcile interval %s of the instances",
+			rotationConfig.TokenExpiration.Duration, instancesctrl.InstallationAutomaticReconcileInterval)
+	}
+	if rotationConfig.AdminKubeconfigExpiration.Duration > maxAdminKubeconfigExpiration {
+		return fmt.Errorf("admin kubeconfig expiration %s must not be longer than %s",
+			rotationConfig.AdminKubeconfigExpiration.Duration, maxAdminKubeconfigExpiration)
+	}
+	return nil
+}
+
+// validateShootProfiles validates the named variants of the shoot configuration.
+// The names are passed as a comma-separated list to the webhooks server, and must therefore not contain commas.
+// The number of worker nodes is validated after the profile has been applied to the shoot configuration.
+func (o *options) validateShootProfiles() error {
+	workers := &o.Config.ShootConfiguration.Workers
+	names := map[string]bool{}
+	for i, profile := range o.Config.ShootProfiles {
+		if len(profile.Name) == 0 || strings.Contains(profile.Name, ",") {
+			return fmt.Errorf("shoot profile %d has an invalid name %q", i, profile.Name)
+		}
+		if names[profile.Name] {
+			return fmt.Errorf("there is more than one shoot profile with name %q", profile.Name)
+		}
+		names[profile.Name] = true
+
+		minimum, maximum := workers.Minimum, workers.Maximum
+		if profile.Minimum != nil {
+			minimum = profile.Minimum
+		}
+		if profile.Maximum != nil {
+			maximum = profile.Maximum
+		}
+		if minimum != nil && maximum != nil && *minimum > *maximum {
+			return fmt.Errorf("the minimum number of worker nodes %d of shoot profile %q must not be greater than the maximum %d", *minimum, profile.Name, *maximum)
+		}
+	}
+	return nil
+}
+
+// validateSupportedVersions validates the supported versions of the landscaper service component.
+func (o *options) validateSupportedVersions() error {
+	componentConfig := &o.Config.LandscaperServiceComponent
+	if len(componentConfig.SupportedVersions) == 0 {
+		return nil
+	}
+
+	if componentConfig.GetSupportedVersion(componentConfig.Version) == nil {
+		return fmt.Errorf("landscaper service component version %q must be one of the supported versions", componentConfig.Version)
+	}
+
+	// only the latest patch version of a minor version can be supported
+	minorVersions := map[string]string{}
+	for _, supportedVersion := range componentConfig.SupportedVersions {
+		v, err := utilversion.ParseSemantic(supportedVersion.Version)
+		if err != nil {
+			return fmt.Errorf("supported landscaper service component version %q is invalid: %w", supportedVersion.Version, err)
+		}
+		minor := fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+		if other, ok := minorVersions[minor]; ok {
+			return fmt.Errorf("supported landscaper service component versions %q and %q have the same minor version", other, supportedVersion.Version)
+		}
+		minorVersions[minor] = supportedVersion.Version
+	}
+
 	return nil
 }
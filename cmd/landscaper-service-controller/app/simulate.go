@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	lssinstall "github.com/gardener/landscaper-service/pkg/apis/core/install"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+)
+
+// simulateSchedulingOptions holds the options of the simulate-scheduling command
+type simulateSchedulingOptions struct {
+	Files []string // Files are the paths of the yaml files containing the resources
+}
+
+// NewSimulateSchedulingCommand creates a new command that simulates the target scheduling of landscaper deployments
+func NewSimulateSchedulingCommand() *cobra.Command {
+	options := &simulateSchedulingOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "simulate-scheduling",
+		Short: "Simulates the target scheduling of landscaper deployments without cluster access",
+		Long: `Simulates the target scheduling of landscaper deployments without cluster access.
+The given files may contain any number of TargetSchedulings, ServiceTargetConfigs, Instances and LandscaperDeployments.
+The rules of the TargetSchedulings are merged in the order in which they occur in the files. Conflicting rules are reported.
+The LandscaperDeployments are scheduled in the order in which they occur in the files.
+The load of the ServiceTargetConfigs is taken from their status.instanceRefs and updated after every scheduled deployment.
+The Instances are the existing instances, which are counted for the maximum number of instances per tenant.`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.run(cmd.OutOrStdout())
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().StringSliceVarP(&options.Files, "file", "f", nil, "Specify the path to a yaml file containing resources, can be repeated")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func (o *simulateSchedulingOptions) run(out io.Writer) error {
+	scheme := runtime.NewScheme()
+	lssinstall.Install(scheme)
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	var (
+		schedulings          []lssv1alpha1.TargetScheduling
+		serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig
+		instances            []lssv1alpha1.Instance
+		deployments          []lssv1alpha1.LandscaperDeployment
+	)
+
+	for _, file := range o.Files {
+		objects, err := readObjects(file, decoder)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range objects {
+			scheme.Default(obj)
+
+			switch typed := obj.(type) {
+			case *lssv1alpha1.TargetScheduling:
+				schedulings = append(schedulings, *typed)
+			case *lssv1alpha1.ServiceTargetConfig:
+				serviceTargetConfigs = append(serviceTargetConfigs, *typed)
+			case *lssv1alpha1.Instance:
+				instances = append(instances, *typed)
+			case *lssv1alpha1.LandscaperDeployment:
+				deployments = append(deployments, *typed)
+			default:
+				return fmt.Errorf("unsupported resource %s in file %s", obj.GetObjectKind().GroupVersionKind().String(), file)
+			}
+		}
+	}
+
+	merged := lssscheduling.MergeTargetSchedulings(schedulings)
+	for _, conflict := range merged.Conflicts {
+		fmt.Fprintf(out, "WARNING: %s\n", conflict.Message)
+	}
+
+	result := lssscheduling.Simulate(merged.Scheduling, serviceTargetConfigs, instances, deployments)
+	for i := range result.Placements {
+		merged.ResolveMatchedRules(result.Placements[i].Decision)
+	}
+	return printSimulationResult(out, result)
+}
+
+// readObjects reads all objects from a (multi-document) yaml file.
+func readObjects(file string, decoder runtime.Decoder) ([]runtime.Object, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file %s: %w", file, err)
+	}
+
+	objects := make([]runtime.Object, 0)
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read yaml document from file %s: %w", file, err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode resource from file %s: %w", file, err)
+		}
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+func printSimulationResult(out io.Writer, result *lssscheduling.SimulationResult) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintln(w, "DEPLOYMENT\tTENANT\tSERVICE TARGET CONFIG\tMESSAGE")
+	for _, placement := range result.Placements {
+		target := "-"
+		message := ""
+		if placement.ServiceTargetConfig != nil {
+			target = placement.ServiceTargetConfig.NamespacedName().String()
+		}
+		if placement.Decision != nil {
+			message = placement.Decision.Message
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", placement.Deployment.NamespacedName().String(), placement.TenantID, target, message)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "SERVICE TARGET CONFIG\tPRIORITY\tRESTRICTED\tINSTANCES\tMAX INSTANCES")
+	for _, config := range result.ServiceTargetConfigs {
+		maxInstances := "-"
+		if config.Spec.MaxInstances != nil {
+			maxInstances = strconv.FormatInt(*config.Spec.MaxInstances, 10)
+		}
+		fmt.Fprintf(w, "%s/%s\t%d\t%t\t%d\t%s\n", config.Namespace, config.Name, config.Spec.Priority,
+			config.Spec.Restricted, len(config.Status.InstanceRefs), maxInstances)
+	}
+
+	return w.Flush()
+}
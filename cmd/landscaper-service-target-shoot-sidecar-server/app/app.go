@@ -65,7 +65,8 @@ func (o *options) run(ctx context.Context) error {
 		Metrics: metricsserver.Options{
 			BindAddress: "0",
 		},
-		NewClient: utils.NewUncachedClient,
+		NewClient:     utils.NewUncachedClient,
+		WebhookServer: o.newWebhookServer(),
 	}
 
 	if o.Config.Metrics != nil {
@@ -114,6 +115,10 @@ func (o *options) run(ctx context.Context) error {
 		return fmt.Errorf("unable to setup subjectsync controller: %w", err)
 	}
 
+	if err := o.registerWebhooks(ctx, mgr); err != nil {
+		return fmt.Errorf("unable to register validation webhooks: %w", err)
+	}
+
 	o.Log.Info("starting the controllers")
 	if err := mgr.Start(ctx); err != nil {
 		o.Log.Error(err, "error while running manager")
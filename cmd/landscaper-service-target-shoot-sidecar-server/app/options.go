@@ -7,26 +7,67 @@ package app
 import (
 	"context"
 	goflag "flag"
+	"fmt"
+	"math"
+	"net/url"
 	"os"
+	"strings"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	configinstall "github.com/gardener/landscaper-service/pkg/apis/config/install"
 	"github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/apis/core"
+	"github.com/gardener/landscaper-service/pkg/webhook"
 
 	flag "github.com/spf13/pflag"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+func defaultWebhookedResources() map[string]webhook.WebhookedResourceDefinition {
+	return map[string]webhook.WebhookedResourceDefinition{
+		"namespaceregistrations": {
+			APIGroup:     core.GroupName,
+			APIVersions:  []string{"v1alpha1"},
+			ResourceName: "namespaceregistrations",
+		},
+		"subjectlists": {
+			APIGroup:     core.GroupName,
+			APIVersions:  []string{"v1alpha1"},
+			ResourceName: "subjectlists",
+		},
+	}
+}
+
 // options holds the landscaper service controller options
 type options struct {
 	Log        logging.Logger // Log is the logger instance
 	ConfigPath string         // ConfigPath is the path to the configuration file
 
 	Config *v1alpha1.TargetShootSidecarConfiguration // Config is the parsed configuration
+
+	webhookPort                 int    // port where the webhook server is running
+	disabledWebhooks            string // lists disabled webhooks as a comma-separated string
+	webhookServiceNamespaceName string // webhook service namespace and name in the format <namespace>/<name>
+	webhookServicePort          int32  // port of the webhook service
+	webhookURL                  string // url under which the webhook server can be reached by the api server
+	certificatesNamespace       string // the namespace in which the webhook credentials are being created/updated
+
+	webhook webhookOptions
+}
+
+// options for the webhook (generated from raw CLI options for easier usage)
+type webhookOptions struct {
+	webhookServiceNamespace string                                // webhook service namespace
+	webhookServiceName      string                                // webhook service name
+	webhookServicePort      int32                                 // port of the webhook service
+	webhookURL              string                                // webhook url
+	certificatesNamespace   string                                // the certificate namespace
+	enabledWebhooks         []webhook.WebhookedResourceDefinition // which resources should be watched by the webhook
 }
 
 // NewOptions returns a new options instance
@@ -37,6 +78,12 @@ func NewOptions() *options {
 // AddFlags adds flags passed via command line
 func (o *options) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&o.ConfigPath, "config", "", "Specify the path to the configuration file")
+	fs.IntVar(&o.webhookPort, "webhook-port", 9443, "Specify the port of the webhook server")
+	fs.StringVar(&o.disabledWebhooks, "disable-webhooks", "", "Specify validation webhooks that should be disabled ('all' to disable validation completely)")
+	fs.StringVar(&o.webhookServiceNamespaceName, "webhook-service", "", "Specify namespace and name of the webhook service (format: <namespace>/<name>)")
+	fs.Int32Var(&o.webhookServicePort, "webhook-service-port", 9443, "Specify the port of the webhook service")
+	fs.StringVar(&o.webhookURL, "webhook-url", "", "Specify the url under which the webhook server can be reached, if the webhook service isn't reachable by the api server of the resource cluster")
+	fs.StringVar(&o.certificatesNamespace, "certificates-namespace", "", "Specify the namespace in which the webhook certificates are stored (defaults to the namespace of the webhook service)")
 	logging.InitFlags(fs)
 	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
 }
@@ -55,8 +102,26 @@ func (o *options) Complete(ctx context.Context) error {
 		return err
 	}
 
-	err = o.validate()
-	return err
+	if err := o.validate(); err != nil {
+		return err
+	}
+
+	o.webhook.webhookServicePort = o.webhookServicePort
+	o.webhook.webhookURL = o.webhookURL
+	if len(o.webhookServiceNamespaceName) != 0 {
+		webhookService := strings.Split(o.webhookServiceNamespaceName, "/")
+		o.webhook.webhookServiceNamespace = webhookService[0]
+		o.webhook.webhookServiceName = webhookService[1]
+	}
+	o.webhook.certificatesNamespace = o.certificatesNamespace
+	if len(o.webhook.certificatesNamespace) == 0 {
+		o.webhook.certificatesNamespace = o.webhook.webhookServiceNamespace
+	}
+	// the validation webhooks are only served, if the webhook server can be reached
+	if len(o.webhookServiceNamespaceName) != 0 || len(o.webhookURL) != 0 {
+		o.webhook.enabledWebhooks = webhook.FilterWebhookedResources(defaultWebhookedResources(), webhook.StringListToMap(o.disabledWebhooks))
+	}
+	return nil
 }
 
 func (o *options) parseConfigurationFile(ctx context.Context) (*v1alpha1.TargetShootSidecarConfiguration, error) {
@@ -81,5 +146,39 @@ func (o *options) parseConfigurationFile(ctx context.Context) (*v1alpha1.TargetS
 }
 
 func (o *options) validate() error {
-	return nil
+	allErrs := field.ErrorList{}
+	dwr := defaultWebhookedResources()
+	if len(o.disabledWebhooks) != 0 {
+		// validate that no unknown values are in the list of to-be-disabled webhooks
+		allowedWebhooks := []string{"all"}
+		for name := range dwr {
+			allowedWebhooks = append(allowedWebhooks, name)
+		}
+		for _, elem := range strings.Split(o.disabledWebhooks, ",") {
+			if _, ok := dwr[elem]; (elem != "all") && !ok {
+				allErrs = append(allErrs, field.NotSupported(field.NewPath("--disable-webhooks"), elem, allowedWebhooks))
+			}
+		}
+	}
+
+	if len(o.webhookServiceNamespaceName) != 0 {
+		ws := strings.Split(o.webhookServiceNamespaceName, "/")
+		if len(ws) != 2 || len(ws[0]) == 0 || len(ws[1]) == 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("--webhook-service"), o.webhookServiceNamespaceName, "must have the format '<namespace>/<name>'"))
+		}
+	}
+
+	if len(o.webhookURL) != 0 {
+		if _, err := url.ParseRequestURI(o.webhookURL); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("--webhook-url"), o.webhookURL, err.Error()))
+		}
+		if len(o.webhookServiceNamespaceName) == 0 && len(o.certificatesNamespace) == 0 {
+			allErrs = append(allErrs, field.Required(field.NewPath("--certificates-namespace"), "must be set if no webhook service is given"))
+		}
+	}
+
+	if o.webhookPort <= 0 || o.webhookPort > math.MaxUint16 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("--webhook-port"), o.webhookPort, fmt.Sprintf("must be in range [0, %d]", math.MaxUint16)))
+	}
+	return allErrs.ToAggregate()
 }
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	webhookcert "github.com/gardener/landscaper/controller-utils/pkg/webhook"
+
+	"github.com/gardener/landscaper-service/pkg/webhook"
+)
+
+const (
+	// validatingWebhookConfigurationName is the name of the ValidatingWebhookConfiguration of the sidecar resources.
+	validatingWebhookConfigurationName = "landscaper-service-sidecar-validation-webhook"
+)
+
+// newWebhookServer creates the webhook server, which serves the validation webhooks of the sidecar resources.
+func (o *options) newWebhookServer() ctrlwebhook.Server {
+	return ctrlwebhook.NewServer(ctrlwebhook.Options{
+		Port:    o.webhookPort,
+		CertDir: webhookCertDir(),
+	})
+}
+
+// webhookCertDir returns the directory, in which the certificates of the webhook server are stored.
+func webhookCertDir() string {
+	return filepath.Join(os.TempDir(), "k8s-webhook-server", "serving-certs")
+}
+
+// registerWebhooks creates the ValidatingWebhookConfiguration in the resource cluster and registers the webhooks,
+// if validation is enabled, and deletes the ValidatingWebhookConfiguration otherwise.
+func (o *options) registerWebhooks(ctx context.Context, mgr manager.Manager) error {
+	webhookLogger := logging.Wrap(ctrl.Log.WithName("webhook").WithName("validation"))
+	ctx = logging.NewContext(ctx, webhookLogger)
+
+	kubeClient := mgr.GetClient()
+
+	// noop if all webhooks are disabled
+	if len(o.webhook.enabledWebhooks) == 0 {
+		webhookLogger.Info("Validation disabled")
+		return webhook.DeleteValidatingWebhookConfiguration(ctx, kubeClient, validatingWebhookConfigurationName)
+	}
+
+	webhookLogger.Info("Validation enabled")
+
+	wo := webhook.Options{
+		WebhookConfigurationName: validatingWebhookConfigurationName,
+		WebhookBasePath:          "/webhook/validate/",
+		WebhookNameSuffix:        ".validation.landscaper-service.gardener.cloud",
+		ObjectSelector: metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Operator: metav1.LabelSelectorOpNotIn,
+					Key:      "validation.landscaper-service.gardener.cloud/skip-validation",
+					Values:   []string{"true"},
+				},
+			},
+		},
+		ServicePort:        o.webhook.webhookServicePort,
+		ServiceName:        o.webhook.webhookServiceName,
+		ServiceNamespace:   o.webhook.webhookServiceNamespace,
+		WebhookURL:         o.webhook.webhookURL,
+		WebhookedResources: o.webhook.enabledWebhooks,
+	}
+
+	// generate certificates
+	var (
+		dnsNames []string
+		err      error
+	)
+	if len(wo.WebhookURL) != 0 {
+		dnsNames, err = webhookcert.GetDNSNamesFromURL(wo.WebhookURL)
+		if err != nil {
+			return fmt.Errorf("unable to get dns names from webhook url: %w", err)
+		}
+	} else {
+		dnsNames = webhookcert.GeDNSNamesFromNamespacedName(wo.ServiceNamespace, wo.ServiceName)
+	}
+	caCert, _, err := webhookcert.GenerateCertificates(ctx, kubeClient, webhookCertDir(),
+		o.webhook.certificatesNamespace, "landscaper-service-sidecar-webhook", "landscaper-service-sidecar-webhook-cert", dnsNames)
+	if err != nil {
+		return fmt.Errorf("unable to generate webhook certificates: %w", err)
+	}
+
+	wo.CABundle = caCert.CertificatePEM
+
+	// log which resources are being watched
+	webhookedResourcesLog := []string{}
+	for _, elem := range wo.WebhookedResources {
+		webhookedResourcesLog = append(webhookedResourcesLog, elem.ResourceName)
+	}
+	webhookLogger.Info("Enabling validation", "resources", webhookedResourcesLog)
+
+	if err := webhook.UpdateValidatingWebhookConfiguration(ctx, kubeClient, wo); err != nil {
+		return err
+	}
+	return webhook.RegisterWebhooks(ctx, mgr.GetWebhookServer(), kubeClient, mgr.GetScheme(), wo)
+}
@@ -29,6 +29,11 @@ import (
 	"github.com/gardener/landscaper-service/pkg/webhook"
 )
 
+const (
+	// mutatingWebhookConfigurationName is the name of the MutatingWebhookConfiguration of the landscaper service.
+	mutatingWebhookConfigurationName = "landscaper-service-mutation-webhook"
+)
+
 // NewLandscaperServiceWebhooksCommand creates a new command for the landscaper service webhooks server
 func NewLandscaperServiceWebhooksCommand(ctx context.Context) *cobra.Command {
 	options := NewOptions()
@@ -106,6 +111,9 @@ func registerWebhooks(ctx context.Context,
 	// noop if all webhooks are disabled
 	if len(o.webhook.enabledWebhooks) == 0 {
 		webhookLogger.Info("Validation disabled")
+		if err := webhook.DeleteMutatingWebhookConfiguration(ctx, kubeClient, mutatingWebhookConfigurationName); err != nil {
+			return err
+		}
 		return webhook.DeleteValidatingWebhookConfiguration(ctx, kubeClient, webhookConfigurationName)
 	}
 
@@ -125,10 +133,13 @@ func registerWebhooks(ctx context.Context,
 				},
 			},
 		},
-		ServicePort:        o.webhook.webhookServicePort,
-		ServiceName:        o.webhook.webhookServiceName,
-		ServiceNamespace:   o.webhook.webhookServiceNamespace,
-		WebhookedResources: o.webhook.enabledWebhooks,
+		ServicePort:             o.webhook.webhookServicePort,
+		ServiceName:             o.webhook.webhookServiceName,
+		ServiceNamespace:        o.webhook.webhookServiceNamespace,
+		WebhookedResources:      o.webhook.enabledWebhooks,
+		SupportedVersions:       o.webhook.supportedVersions,
+		ShootProfiles:           o.webhook.shootProfiles,
+		ShootKubernetesVersions: o.webhook.shootKubernetesVersions,
 	}
 
 	// generate certificates
@@ -158,5 +169,43 @@ func registerWebhooks(ctx context.Context,
 		return err
 	}
 
-	return nil
+	return registerMutatingWebhooks(ctx, webhookServer, kubeClient, scheme, wo, o)
+}
+
+// registerMutatingWebhooks creates the MutatingWebhookConfiguration and registers the mutating webhooks, if landscape-wide defaults are configured.
+// The mutating webhooks share the service and certificates with the validation webhooks.
+func registerMutatingWebhooks(ctx context.Context,
+	webhookServer ctrlwebhook.Server,
+	kubeClient client.Client,
+	scheme *runtime.Scheme,
+	wo webhook.Options,
+	o *options) error {
+
+	webhookLogger := logging.Wrap(ctrl.Log.WithName("webhook").WithName("mutation"))
+	ctx = logging.NewContext(ctx, webhookLogger)
+
+	// noop if no defaults are configured
+	if len(o.webhook.mutatedWebhooks) == 0 {
+		webhookLogger.Info("Mutation disabled")
+		return webhook.DeleteMutatingWebhookConfiguration(ctx, kubeClient, mutatingWebhookConfigurationName)
+	}
+
+	mo := wo
+	mo.WebhookConfigurationName = mutatingWebhookConfigurationName
+	mo.WebhookBasePath = "/webhook/mutate/"
+	mo.WebhookNameSuffix = ".mutation.landscaper-service.gardener.cloud"
+	mo.WebhookedResources = o.webhook.mutatedWebhooks
+	mo.Defaults = o.webhook.defaults
+
+	// log which resources are being mutated
+	webhookedResourcesLog := []string{}
+	for _, elem := range mo.WebhookedResources {
+		webhookedResourcesLog = append(webhookedResourcesLog, elem.ResourceName)
+	}
+	webhookLogger.Info("Enabling mutation", "resources", webhookedResourcesLog)
+
+	if err := webhook.UpdateMutatingWebhookConfiguration(ctx, kubeClient, mo); err != nil {
+		return err
+	}
+	return webhook.RegisterMutatingWebhooks(ctx, webhookServer, scheme, mo)
 }
@@ -8,12 +8,17 @@ import (
 	goflag "flag"
 	"fmt"
 	"math"
+	"os"
 	"strings"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	flag "github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	configinstall "github.com/gardener/landscaper-service/pkg/apis/config/install"
+	lssconfig "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/apis/core"
 	"github.com/gardener/landscaper-service/pkg/webhook"
 )
@@ -40,6 +45,16 @@ func defaultWebhookedResources() map[string]webhook.WebhookedResourceDefinition
 			APIVersions:  []string{"v1alpha1"},
 			ResourceName: "targetschedulings",
 		},
+		"tenantquotas": {
+			APIGroup:     core.GroupName,
+			APIVersions:  []string{"v1alpha1"},
+			ResourceName: "tenantquotas",
+		},
+		"rebalanceplans": {
+			APIGroup:     core.GroupName,
+			APIVersions:  []string{"v1alpha1"},
+			ResourceName: "rebalanceplans",
+		},
 	}
 }
 
@@ -51,6 +66,10 @@ type options struct {
 	webhookServiceNamespaceName string         // webhook service namespace and name in the format <namespace>/<name>
 	webhookServicePort          int32          // port of the webhook service
 	certificatesNamespace       string         // the namespace in which the webhook credentials are being created/updated
+	supportedVersions           string         // lists the supported landscaper service component versions as a comma-separated string
+	shootProfiles               string         // lists the shoot profiles in the format <name>[=<kubernetesVersion>] as a comma-separated string
+	shootKubernetesVersion      string         // the kubernetes version of the default shoot configuration
+	defaultsConfigPath          string         // path to the file containing the landscape-wide defaults for the mutating webhooks
 
 	webhook webhookOptions
 }
@@ -62,6 +81,11 @@ type webhookOptions struct {
 	webhookServicePort      int32                                 // port of the webhook service
 	certificatesNamespace   string                                // the certificate namespace
 	enabledWebhooks         []webhook.WebhookedResourceDefinition // which resources should be watched by the webhook
+	supportedVersions       []string                              // the landscaper service component versions which can be selected
+	shootProfiles           []string                              // the names of the shoot profiles which can be selected
+	shootKubernetesVersions map[string]string                     // the kubernetes versions of the shoot profiles and the default shoot configuration
+	mutatedWebhooks         []webhook.WebhookedResourceDefinition // which resources should be defaulted by the mutating webhooks
+	defaults                *lssconfig.LandscaperServiceDefaults  // the landscape-wide defaults applied by the mutating webhooks
 }
 
 // NewOptions returns a new options instance
@@ -77,6 +101,10 @@ func (o *options) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&o.disabledWebhooks, "disable-webhooks", "", "Specify validation webhooks that should be disabled ('all' to disable validation completely)")
 	fs.StringVar(&o.webhookServiceNamespaceName, "webhook-service", "", "Specify namespace and name of the webhook service (format: <namespace>/<name>)")
 	fs.Int32Var(&o.webhookServicePort, "webhook-service-port", 9443, "Specify the port of the webhook service")
+	fs.StringVar(&o.supportedVersions, "supported-versions", "", "Specify the landscaper service component versions which can be selected by landscaper deployments as a comma-separated string")
+	fs.StringVar(&o.shootProfiles, "shoot-profiles", "", "Specify the shoot profiles which can be selected by landscaper deployments as a comma-separated string (format: <name>[=<kubernetesVersion>])")
+	fs.StringVar(&o.shootKubernetesVersion, "shoot-kubernetes-version", "", "Specify the kubernetes version of the default shoot configuration")
+	fs.StringVar(&o.defaultsConfigPath, "defaults-config", "", "Specify the path to the file containing the landscape-wide defaults, which are applied by the mutating webhooks")
 	logging.InitFlags(fs)
 
 	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
@@ -97,16 +125,55 @@ func (o *options) Complete() error {
 
 	allErrs := field.ErrorList{}
 	o.webhook.webhookServicePort = o.webhookServicePort
-	o.webhook.enabledWebhooks = filterWebhookedResources(defaultWebhookedResources(), stringListToMap(o.disabledWebhooks))
+	o.webhook.enabledWebhooks = webhook.FilterWebhookedResources(defaultWebhookedResources(), webhook.StringListToMap(o.disabledWebhooks))
 	if len(o.webhook.enabledWebhooks) != 0 && len(o.webhookServiceNamespaceName) != 0 {
 		webhookService := strings.Split(o.webhookServiceNamespaceName, "/")
 		o.webhook.webhookServiceNamespace = webhookService[0]
 		o.webhook.webhookServiceName = webhookService[1]
 	}
 	o.webhook.certificatesNamespace = getCertificateNamespace(o)
+	o.webhook.supportedVersions = stringListToSlice(o.supportedVersions)
+	o.webhook.shootProfiles, o.webhook.shootKubernetesVersions = parseShootProfiles(o.shootProfiles, o.shootKubernetesVersion)
+
+	if len(o.defaultsConfigPath) != 0 {
+		o.webhook.defaults, err = parseDefaultsFile(o.defaultsConfigPath)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("--defaults-config"), o.defaultsConfigPath, err.Error()))
+		}
+		o.webhook.mutatedWebhooks = filterMutatedResources(o.webhook.enabledWebhooks)
+	}
 	return allErrs.ToAggregate()
 }
 
+// parseDefaultsFile reads the landscape-wide defaults from the given file
+func parseDefaultsFile(path string) (*lssconfig.LandscaperServiceDefaults, error) {
+	configScheme := runtime.NewScheme()
+	configinstall.Install(configScheme)
+	decoder := serializer.NewCodecFactory(configScheme).UniversalDecoder()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := &lssconfig.LandscaperServiceDefaults{}
+	if _, _, err := decoder.Decode(data, nil, defaults); err != nil {
+		return nil, err
+	}
+	return defaults, nil
+}
+
+// filterMutatedResources returns a slice of those of the given webhookedResources, for which a mutating webhook exists
+func filterMutatedResources(webhookedResources []webhook.WebhookedResourceDefinition) []webhook.WebhookedResourceDefinition {
+	mwr := []webhook.WebhookedResourceDefinition{}
+	for _, wr := range webhookedResources {
+		if webhook.HasMutator(wr.ResourceName) {
+			mwr = append(mwr, wr)
+		}
+	}
+	return mwr
+}
+
 func (o *options) validate() error {
 	allErrs := field.ErrorList{}
 	dwr := defaultWebhookedResources()
@@ -140,20 +207,6 @@ func (o *options) validate() error {
 	return allErrs.ToAggregate()
 }
 
-// filterWebhookedResources returns a slice of WebhookedResourceDefinitions that contains only those of the given webhookedResources whose ResourceName is not specified in disabledWebhooks
-func filterWebhookedResources(webhookedResources map[string]webhook.WebhookedResourceDefinition, disabledWebhooks map[string]bool) []webhook.WebhookedResourceDefinition {
-	fwr := []webhook.WebhookedResourceDefinition{}
-	if _, ok := disabledWebhooks["all"]; ok {
-		return fwr // all webhooks disabled, return empty slice
-	}
-	for _, wr := range webhookedResources {
-		if _, ok := disabledWebhooks[wr.ResourceName]; !ok {
-			fwr = append(fwr, wr)
-		}
-	}
-	return fwr
-}
-
 // allowedWebhookDisables computes a list of allowed values for the '--disable-webhooks' option
 func allowedWebhookDisables() []string {
 	dwr := defaultWebhookedResources()
@@ -167,16 +220,40 @@ func allowedWebhookDisables() []string {
 	return res
 }
 
-// stringListToMap turns a comma-separated list of strings into pseudo-set that maps all elements of the list to true
-func stringListToMap(opt string) map[string]bool {
-	res := map[string]bool{}
-	tmp := strings.Split(opt, ",")
-	for _, t := range tmp {
-		res[t] = true
+// stringListToSlice turns a comma-separated list of strings into a slice, omitting empty elements
+func stringListToSlice(opt string) []string {
+	res := []string{}
+	for _, t := range strings.Split(opt, ",") {
+		if t = strings.TrimSpace(t); len(t) > 0 {
+			res = append(res, t)
+		}
 	}
 	return res
 }
 
+// parseShootProfiles turns a comma-separated list of shoot profiles in the format <name>[=<kubernetesVersion>]
+// into the names of the shoot profiles and their kubernetes versions.
+// The kubernetes version of the default shoot configuration is stored under the empty name.
+func parseShootProfiles(opt, defaultKubernetesVersion string) ([]string, map[string]string) {
+	names := []string{}
+	kubernetesVersions := map[string]string{}
+	if len(defaultKubernetesVersion) > 0 {
+		kubernetesVersions[""] = defaultKubernetesVersion
+	}
+	for _, profile := range stringListToSlice(opt) {
+		name, kubernetesVersion, _ := strings.Cut(profile, "=")
+		name = strings.TrimSpace(name)
+		if len(name) == 0 {
+			continue
+		}
+		names = append(names, name)
+		if kubernetesVersion = strings.TrimSpace(kubernetesVersion); len(kubernetesVersion) > 0 {
+			kubernetesVersions[name] = kubernetesVersion
+		}
+	}
+	return names, kubernetesVersions
+}
+
 // getCertificateNamespace returns the namespace to use for storing the webhooks server certificate
 func getCertificateNamespace(opt *options) string {
 	if len(opt.certificatesNamespace) != 0 {
@@ -6,7 +6,6 @@ package tests
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 	"strings"
 
@@ -351,8 +350,8 @@ func (r *NamespaceregistrationSubjectSyncRunner) checkInitialSetup(deployment *l
 	logger, _ := logging.FromContextOrNew(r.ctx, nil)
 	logger.Info("check initial setup for deployment", "name", deployment.Name)
 
-	// get admin kubeconfig for resource-shoot cluster from landscaperdeployment.status.instanceRef - Instance.Status.AdminKubeconfig
-	logger.Info("build kube client from config in instance.Status")
+	// get admin kubeconfig for resource-shoot cluster from landscaperdeployment.status.instanceRef - Instance.Status.AdminKubeconfigSecretRef
+	logger.Info("build kube client from admin kubeconfig secret of instance")
 	if deployment.Status.InstanceRef.Name == "" || deployment.Status.InstanceRef.Namespace == "" {
 		return fmt.Errorf("deployment %q instance ref empty", deployment.Name)
 	}
@@ -365,13 +364,13 @@ func (r *NamespaceregistrationSubjectSyncRunner) checkInitialSetup(deployment *l
 		return fmt.Errorf("failed to get instance for deployment %q: %w", deployment.Name, err)
 	}
 
-	if instance.Status.AdminKubeconfig == "" {
-		return fmt.Errorf("instance %q for deployment %q missing AdminKubeconfig", instance.Name, deployment.Name)
+	if instance.Status.AdminKubeconfigSecretRef == nil {
+		return fmt.Errorf("instance %q for deployment %q missing AdminKubeconfigSecretRef", instance.Name, deployment.Name)
 	}
 
-	kubeconfig, err := base64.StdEncoding.DecodeString(instance.Status.AdminKubeconfig)
+	kubeconfig, err := util.GetKubeconfigFromSecret(r.ctx, r.clusterClients.TestCluster, instance.Status.AdminKubeconfigSecretRef)
 	if err != nil {
-		return fmt.Errorf("failed to decode admin kubeconfig of instance %q/%q: %w", instance.Namespace, instance.Name, err)
+		return fmt.Errorf("failed to get admin kubeconfig of instance %q/%q: %w", instance.Namespace, instance.Name, err)
 	}
 
 	//build client
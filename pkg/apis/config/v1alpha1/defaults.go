@@ -19,6 +19,9 @@ func addDefaultingFuncs(scheme *runtime.Scheme) error {
 func SetDefaults_LandscaperServiceConfiguration(obj *LandscaperServiceConfiguration) {
 	SetDefaults_CrdManagementConfiguration(&obj.CrdManagement)
 	SetDefaults_AvailabilityMonitoringConfiguration(&obj.AvailabilityMonitoring)
+	SetDefaults_ServiceTargetConfigProbeConfiguration(&obj.ServiceTargetConfigProbe)
+	SetDefaults_UpgradeConfiguration(&obj.Upgrade)
+	SetDefaults_CredentialRotationConfiguration(&obj.CredentialRotation)
 }
 
 // SetDefaults_CrdManagementConfiguration sets the defaults for the crd management configuration.
@@ -55,6 +58,36 @@ func SetDefaults_AvailabilityMonitoringConfiguration(obj *AvailabilityMonitoring
 	}
 }
 
+// SetDefaults_ServiceTargetConfigProbeConfiguration sets the defaults for the service target config probe configuration.
+func SetDefaults_ServiceTargetConfigProbeConfiguration(obj *ServiceTargetConfigProbeConfiguration) {
+	if obj.Interval.Duration == 0 {
+		obj.Interval.Duration = time.Minute * 5
+	}
+	if obj.Timeout.Duration == 0 {
+		obj.Timeout.Duration = time.Second * 30
+	}
+}
+
+// SetDefaults_UpgradeConfiguration sets the defaults for the upgrade configuration.
+func SetDefaults_UpgradeConfiguration(obj *UpgradeConfiguration) {
+	if obj.MaxConcurrentUpgrades <= 0 {
+		obj.MaxConcurrentUpgrades = 1
+	}
+	if obj.CheckInterval.Duration == 0 {
+		obj.CheckInterval.Duration = time.Minute * 1
+	}
+}
+
+// SetDefaults_CredentialRotationConfiguration sets the defaults for the credential rotation configuration.
+func SetDefaults_CredentialRotationConfiguration(obj *CredentialRotationConfiguration) {
+	if obj.TokenExpiration.Duration == 0 {
+		obj.TokenExpiration.Duration = time.Hour * 24 * 90
+	}
+	if obj.AdminKubeconfigExpiration.Duration == 0 {
+		obj.AdminKubeconfigExpiration.Duration = time.Hour * 24
+	}
+}
+
 // SetDefaults_ShootConfiguration sets the defaults for the shoot configuration.
 func SetDefaults_ShootConfiguration(obj *ShootConfiguration) {
 	maintenance := &obj.Maintenance
@@ -36,6 +36,7 @@ var (
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&LandscaperServiceConfiguration{},
+		&LandscaperServiceDefaults{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return addDefaultingFuncs(scheme)
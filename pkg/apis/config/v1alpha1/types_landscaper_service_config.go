@@ -5,9 +5,13 @@
 package v1alpha1
 
 import (
+	"time"
+
 	"github.com/gardener/landscaper/apis/core/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -36,6 +40,15 @@ type LandscaperServiceConfiguration struct {
 	// ShootConfiguration is the specification to the gardener shoots.
 	ShootConfiguration ShootConfiguration `json:"shootConfiguration"`
 
+	// ShootRegionProfiles override the region specific parts of the shoot configuration,
+	// for the shoot clusters of instances which are created in another region than the one of the shoot configuration.
+	// +optional
+	ShootRegionProfiles []ShootRegionProfile `json:"shootRegionProfiles,omitempty"`
+
+	// ShootProfiles are named variants of the shoot configuration, which can be selected by landscaper deployments.
+	// +optional
+	ShootProfiles []ShootProfile `json:"shootProfiles,omitempty"`
+
 	// AuditLogConfig is the audit log configuration for the created shoots.
 	// +optional
 	AuditLogConfig *AuditLogConfiguration `json:"auditLogConfig"`
@@ -44,6 +57,87 @@ type LandscaperServiceConfiguration struct {
 	// which defines rules how ServiceTargetConfigs are assigned to LandscaperDeployments.
 	// +optional
 	Scheduling *v1alpha1.ObjectReference `json:"scheduling,omitempty"`
+
+	// SchedulingSelector selects TargetScheduling resources in all namespaces by their labels.
+	// The rules of the selected TargetSchedulings are merged with the rules of the TargetScheduling referenced by Scheduling.
+	// +optional
+	SchedulingSelector *metav1.LabelSelector `json:"schedulingSelector,omitempty"`
+
+	// ServiceTargetConfigProbe configures the periodic probing of the target clusters of the ServiceTargetConfigs.
+	// +optional
+	ServiceTargetConfigProbe ServiceTargetConfigProbeConfiguration `json:"serviceTargetConfigProbe,omitempty"`
+
+	// Upgrade configures the roll out of landscaper service component version changes to the instances.
+	// +optional
+	Upgrade UpgradeConfiguration `json:"upgrade,omitempty"`
+
+	// CredentialRotation configures the validity of the credentials which are generated for the instances.
+	// +optional
+	CredentialRotation CredentialRotationConfiguration `json:"credentialRotation,omitempty"`
+
+	// SidecarWebhooksServer enables the validation webhooks of the target shoot sidecar server,
+	// e.g. for namespace registrations and subject lists. If not set, the webhooks are not registered.
+	// +optional
+	SidecarWebhooksServer *SidecarWebhooksServerConfiguration `json:"sidecarWebhooksServer,omitempty"`
+}
+
+// SidecarWebhooksServerConfiguration is the configuration for the webhooks server of the target shoot sidecar.
+type SidecarWebhooksServerConfiguration struct {
+	// URL is the url under which the api server of the resource cluster can reach the sidecar webhooks server.
+	URL string `json:"url"`
+
+	// CertificatesNamespace is the namespace in which the webhook certificates are stored.
+	CertificatesNamespace string `json:"certificatesNamespace"`
+}
+
+// CredentialRotationConfiguration is the configuration for the rotation of the credentials of the instances.
+type CredentialRotationConfiguration struct {
+	// TokenExpiration defines how long the tokens are valid, which the landscaper and sidecar controllers use
+	// to access the resource cluster, e.g. for watching installations, namespace registrations etc.
+	// The tokens are renewed with every reconciliation of an instance installation, at the latest after 14 days.
+	// Therefore, the value must be larger than 14 days.
+	// Defaults to 90 days.
+	// +optional
+	TokenExpiration v1alpha1.Duration `json:"tokenExpiration,omitempty"`
+
+	// AdminKubeconfigExpiration defines how long the admin kubeconfig for a resource cluster is valid.
+	// This kubeconfig is used to deploy RBAC objects on the resource cluster.
+	// Each reconciliation uses a new kubeconfig, so that a short duration suffices.
+	// Defaults to 1 day, which is also the maximum.
+	// +optional
+	AdminKubeconfigExpiration v1alpha1.Duration `json:"adminKubeconfigExpiration,omitempty"`
+}
+
+// UpgradeConfiguration is the configuration for the roll out of landscaper service component version changes to the instances.
+type UpgradeConfiguration struct {
+	// MaxConcurrentUpgrades is the maximum number of instances in the landscape which are upgraded at the same time.
+	// An upgrade is in progress until the upgraded instance is ready and healthy.
+	// Defaults to 1.
+	// +optional
+	MaxConcurrentUpgrades int `json:"maxConcurrentUpgrades,omitempty"`
+
+	// CheckInterval specifies the duration after which pending upgrades are checked again.
+	// Defaults to 1 minute.
+	// +optional
+	CheckInterval v1alpha1.Duration `json:"checkInterval,omitempty"`
+
+	// DefaultMaintenanceWindow is the maintenance window of instances which do not specify a maintenance window.
+	// If not set, these instances can be upgraded at any time.
+	// +optional
+	DefaultMaintenanceWindow *lssv1alpha1.MaintenanceWindow `json:"defaultMaintenanceWindow,omitempty"`
+}
+
+// ServiceTargetConfigProbeConfiguration is the configuration for the probing of the target clusters of the ServiceTargetConfigs.
+type ServiceTargetConfigProbeConfiguration struct {
+	// Interval specifies the duration between two probes of a target cluster.
+	// Defaults to 5 minutes.
+	// +optional
+	Interval v1alpha1.Duration `json:"interval,omitempty"`
+
+	// Timeout specifies the timeout of a single probe of a target cluster.
+	// Defaults to 30 seconds.
+	// +optional
+	Timeout v1alpha1.Duration `json:"timeout,omitempty"`
 }
 
 // AvailabilityMonitoringConfiguration is the configuration for the availability monitoring of the provisioned landscaper
@@ -98,9 +192,15 @@ type LandscaperServiceComponentConfiguration struct {
 	// Name is the component name
 	Name string `json:"name"`
 
-	// Version is the component version
+	// Version is the component version.
+	// It is used for all landscaper deployments which do not select a version.
 	Version string `json:"version"`
 
+	// SupportedVersions is the list of component versions which can be selected by landscaper deployments.
+	// If the list is empty, only the component version is supported.
+	// +optional
+	SupportedVersions []SupportedVersion `json:"supportedVersions,omitempty"`
+
 	// RepositoryContext specifies the repository context for accessing the landscaper service component.
 	RepositoryContext v1alpha1.AnyJSON `json:"repositoryContext"`
 
@@ -109,6 +209,64 @@ type LandscaperServiceComponentConfiguration struct {
 	RegistryPullSecrets []corev1.SecretReference `json:"registryPullSecrets,omitempty"`
 }
 
+// SupportedVersion is a landscaper service component version which can be selected by landscaper deployments.
+type SupportedVersion struct {
+	// Version is the component version.
+	Version string `json:"version"`
+
+	// DeprecationDate is the date since which the version is deprecated.
+	// Deprecated versions can still be selected, but should be upgraded.
+	// +optional
+	DeprecationDate *metav1.Time `json:"deprecationDate,omitempty"`
+}
+
+// IsDeprecated returns whether the version is deprecated at the given time.
+func (v *SupportedVersion) IsDeprecated(now time.Time) bool {
+	return v.DeprecationDate != nil && !now.Before(v.DeprecationDate.Time)
+}
+
+// GetSupportedVersions returns the supported component versions.
+// If no supported versions are configured, the component version is the only supported version.
+func (c *LandscaperServiceComponentConfiguration) GetSupportedVersions() []SupportedVersion {
+	if len(c.SupportedVersions) == 0 {
+		return []SupportedVersion{{Version: c.Version}}
+	}
+	return c.SupportedVersions
+}
+
+// GetSupportedVersion returns the supported version with the given name, or nil if the version is not supported.
+func (c *LandscaperServiceComponentConfiguration) GetSupportedVersion(version string) *SupportedVersion {
+	supportedVersions := c.GetSupportedVersions()
+	for i := range supportedVersions {
+		if supportedVersions[i].Version == version {
+			return &supportedVersions[i]
+		}
+	}
+	return nil
+}
+
+// GetShootRegionProfiles returns the shoot region profiles of the given region.
+// If a provider type is given, only the profiles of this provider type are returned.
+func (c *LandscaperServiceConfiguration) GetShootRegionProfiles(region, providerType string) []ShootRegionProfile {
+	profiles := make([]ShootRegionProfile, 0)
+	for _, profile := range c.ShootRegionProfiles {
+		if profile.Region == region && (len(providerType) == 0 || profile.Provider.Type == providerType) {
+			profiles = append(profiles, profile)
+		}
+	}
+	return profiles
+}
+
+// GetShootProfile returns the shoot profile with the given name, or nil if there is no such profile.
+func (c *LandscaperServiceConfiguration) GetShootProfile(name string) *ShootProfile {
+	for i := range c.ShootProfiles {
+		if c.ShootProfiles[i].Name == name {
+			return &c.ShootProfiles[i]
+		}
+	}
+	return nil
+}
+
 // GardenerConfiguration is the gardener specific configuration required for shoot management.
 type GardenerConfiguration struct {
 	// ServiceAccountKubeconfig is the reference to the secret containing the service account kubeconfig.
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LandscaperServiceDefaults contains the landscape-wide defaults for landscaper service resources.
+// The defaults are applied by the mutating webhooks of the landscaper service webhooks server.
+type LandscaperServiceDefaults struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// LandscaperConfiguration contains the defaults for the landscaper configuration of landscaper deployments and instances.
+	// A field is only defaulted, if it is not set in the resource.
+	// +optional
+	LandscaperConfiguration *lssv1alpha1.LandscaperConfiguration `json:"landscaperConfiguration,omitempty"`
+}
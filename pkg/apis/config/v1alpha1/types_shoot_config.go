@@ -23,6 +23,52 @@ type ShootConfiguration struct {
 	// ControlPlane holds general control plane settings.
 	// +optional
 	ControlPlane *ControlPlane `json:"controlPlane,omitempty"`
+	// Hibernation specifies the hibernation of the shoot cluster.
+	// +optional
+	Hibernation *ShootHibernation `json:"hibernation,omitempty"`
+}
+
+// ShootRegionProfile holds the region specific parts of the configuration of a gardener shoot cluster.
+// They replace the corresponding parts of the shoot configuration for the shoot clusters in this region.
+type ShootRegionProfile struct {
+	// Region is the region of the shoot clusters to which this profile applies.
+	Region string `json:"region"`
+	// Provider is the shoot provider configuration in this region.
+	Provider ShootProviderConfiguration `json:"provider"`
+	// Machine specifies the machine type used for worker nodes in this region.
+	// If not set, the machine of the shoot configuration is used.
+	// +optional
+	Machine *ShootMachineConfiguration `json:"machine,omitempty"`
+	// Volume specifies the volume configuration for the worker nodes in this region.
+	// If not set, the volume of the shoot configuration is used.
+	// +optional
+	Volume *ShootWorkerVolumeConfiguration `json:"volume,omitempty"`
+}
+
+// ShootProfile is a named variant of the shoot configuration, which can be selected by landscaper deployments,
+// e.g. for tenants with heavy deploy loads which need bigger or more worker nodes.
+// The fields which are set replace the corresponding fields of the shoot configuration.
+type ShootProfile struct {
+	// Name is the name under which the profile is selected by landscaper deployments.
+	Name string `json:"name"`
+	// MachineType is the cloud provider specific virtual machine type used for worker nodes.
+	// +optional
+	MachineType string `json:"machineType,omitempty"`
+	// Minimum is the minimum amount of worker nodes available.
+	// +optional
+	Minimum *int32 `json:"minimum,omitempty"`
+	// Maximum is the maximum amount of worker nodes available.
+	// +optional
+	Maximum *int32 `json:"maximum,omitempty"`
+	// VolumeSize is the size of the volume of the worker nodes.
+	// +optional
+	VolumeSize string `json:"volumeSize,omitempty"`
+	// KubernetesVersion is the kubernetes version to use.
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// MaintenanceTimeWindow is the time window during which auto updates are performed.
+	// +optional
+	MaintenanceTimeWindow *ShootMaintenanceTimeWindow `json:"maintenanceTimeWindow,omitempty"`
 }
 
 // ShootProviderConfiguration is the shoot provider configuration.
@@ -138,3 +184,11 @@ type HighAvailability struct {
 type FailureTolerance struct {
 	Type string `json:"type"`
 }
+
+// ShootHibernation specifies the hibernation of the shoot cluster.
+// The hibernation schedules are evaluated by the landscaper service controller, which enables the hibernation
+// of the shoot cluster, after the deployments accessing it have been scaled down.
+type ShootHibernation struct {
+	// Enabled specifies whether the shoot cluster is hibernated.
+	Enabled bool `json:"enabled"`
+}
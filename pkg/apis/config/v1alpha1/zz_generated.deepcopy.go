@@ -10,8 +10,10 @@
 package v1alpha1
 
 import (
+	apiscorev1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -131,6 +133,24 @@ func (in *CrdManagementConfiguration) DeepCopy() *CrdManagementConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRotationConfiguration) DeepCopyInto(out *CredentialRotationConfiguration) {
+	*out = *in
+	out.TokenExpiration = in.TokenExpiration
+	out.AdminKubeconfigExpiration = in.AdminKubeconfigExpiration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRotationConfiguration.
+func (in *CredentialRotationConfiguration) DeepCopy() *CredentialRotationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRotationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailureTolerance) DeepCopyInto(out *FailureTolerance) {
 	*out = *in
@@ -202,6 +222,13 @@ func (in *KubeAPIServerConfig) DeepCopy() *KubeAPIServerConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LandscaperServiceComponentConfiguration) DeepCopyInto(out *LandscaperServiceComponentConfiguration) {
 	*out = *in
+	if in.SupportedVersions != nil {
+		in, out := &in.SupportedVersions, &out.SupportedVersions
+		*out = make([]SupportedVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.RepositoryContext.DeepCopyInto(&out.RepositoryContext)
 	if in.RegistryPullSecrets != nil {
 		in, out := &in.RegistryPullSecrets, &out.RegistryPullSecrets
@@ -235,6 +262,20 @@ func (in *LandscaperServiceConfiguration) DeepCopyInto(out *LandscaperServiceCon
 	in.LandscaperServiceComponent.DeepCopyInto(&out.LandscaperServiceComponent)
 	out.GardenerConfiguration = in.GardenerConfiguration
 	in.ShootConfiguration.DeepCopyInto(&out.ShootConfiguration)
+	if in.ShootRegionProfiles != nil {
+		in, out := &in.ShootRegionProfiles, &out.ShootRegionProfiles
+		*out = make([]ShootRegionProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ShootProfiles != nil {
+		in, out := &in.ShootProfiles, &out.ShootProfiles
+		*out = make([]ShootProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AuditLogConfig != nil {
 		in, out := &in.AuditLogConfig, &out.AuditLogConfig
 		*out = new(AuditLogConfiguration)
@@ -245,6 +286,19 @@ func (in *LandscaperServiceConfiguration) DeepCopyInto(out *LandscaperServiceCon
 		*out = new(corev1alpha1.ObjectReference)
 		**out = **in
 	}
+	if in.SchedulingSelector != nil {
+		in, out := &in.SchedulingSelector, &out.SchedulingSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	out.ServiceTargetConfigProbe = in.ServiceTargetConfigProbe
+	in.Upgrade.DeepCopyInto(&out.Upgrade)
+	out.CredentialRotation = in.CredentialRotation
+	if in.SidecarWebhooksServer != nil {
+		in, out := &in.SidecarWebhooksServer, &out.SidecarWebhooksServer
+		*out = new(SidecarWebhooksServerConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -266,6 +320,36 @@ func (in *LandscaperServiceConfiguration) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LandscaperServiceDefaults) DeepCopyInto(out *LandscaperServiceDefaults) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.LandscaperConfiguration != nil {
+		in, out := &in.LandscaperConfiguration, &out.LandscaperConfiguration
+		*out = new(apiscorev1alpha1.LandscaperConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscaperServiceDefaults.
+func (in *LandscaperServiceDefaults) DeepCopy() *LandscaperServiceDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(LandscaperServiceDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LandscaperServiceDefaults) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsConfiguration) DeepCopyInto(out *MetricsConfiguration) {
 	*out = *in
@@ -298,6 +382,24 @@ func (in *OIDCConfig) DeepCopy() *OIDCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceTargetConfigProbeConfiguration) DeepCopyInto(out *ServiceTargetConfigProbeConfiguration) {
+	*out = *in
+	out.Interval = in.Interval
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceTargetConfigProbeConfiguration.
+func (in *ServiceTargetConfigProbeConfiguration) DeepCopy() *ServiceTargetConfigProbeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceTargetConfigProbeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootAutoUpdateConfig) DeepCopyInto(out *ShootAutoUpdateConfig) {
 	*out = *in
@@ -336,6 +438,11 @@ func (in *ShootConfiguration) DeepCopyInto(out *ShootConfiguration) {
 		*out = new(ControlPlane)
 		**out = **in
 	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(ShootHibernation)
+		**out = **in
+	}
 	return
 }
 
@@ -349,6 +456,22 @@ func (in *ShootConfiguration) DeepCopy() *ShootConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootHibernation) DeepCopyInto(out *ShootHibernation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootHibernation.
+func (in *ShootHibernation) DeepCopy() *ShootHibernation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootHibernation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootKubernetesConfig) DeepCopyInto(out *ShootKubernetesConfig) {
 	*out = *in
@@ -433,6 +556,37 @@ func (in *ShootMaintenanceTimeWindow) DeepCopy() *ShootMaintenanceTimeWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootProfile) DeepCopyInto(out *ShootProfile) {
+	*out = *in
+	if in.Minimum != nil {
+		in, out := &in.Minimum, &out.Minimum
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Maximum != nil {
+		in, out := &in.Maximum, &out.Maximum
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaintenanceTimeWindow != nil {
+		in, out := &in.MaintenanceTimeWindow, &out.MaintenanceTimeWindow
+		*out = new(ShootMaintenanceTimeWindow)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootProfile.
+func (in *ShootProfile) DeepCopy() *ShootProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootProviderConfiguration) DeepCopyInto(out *ShootProviderConfiguration) {
 	*out = *in
@@ -449,6 +603,33 @@ func (in *ShootProviderConfiguration) DeepCopy() *ShootProviderConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRegionProfile) DeepCopyInto(out *ShootRegionProfile) {
+	*out = *in
+	out.Provider = in.Provider
+	if in.Machine != nil {
+		in, out := &in.Machine, &out.Machine
+		*out = new(ShootMachineConfiguration)
+		**out = **in
+	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(ShootWorkerVolumeConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRegionProfile.
+func (in *ShootRegionProfile) DeepCopy() *ShootRegionProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRegionProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootWorkerVolumeConfiguration) DeepCopyInto(out *ShootWorkerVolumeConfiguration) {
 	*out = *in
@@ -503,6 +684,42 @@ func (in *ShootWorkersConfiguration) DeepCopy() *ShootWorkersConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarWebhooksServerConfiguration) DeepCopyInto(out *SidecarWebhooksServerConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarWebhooksServerConfiguration.
+func (in *SidecarWebhooksServerConfiguration) DeepCopy() *SidecarWebhooksServerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarWebhooksServerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportedVersion) DeepCopyInto(out *SupportedVersion) {
+	*out = *in
+	if in.DeprecationDate != nil {
+		in, out := &in.DeprecationDate, &out.DeprecationDate
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportedVersion.
+func (in *SupportedVersion) DeepCopy() *SupportedVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportedVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetShootSidecarConfiguration) DeepCopyInto(out *TargetShootSidecarConfiguration) {
 	*out = *in
@@ -533,3 +750,25 @@ func (in *TargetShootSidecarConfiguration) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeConfiguration) DeepCopyInto(out *UpgradeConfiguration) {
+	*out = *in
+	out.CheckInterval = in.CheckInterval
+	if in.DefaultMaintenanceWindow != nil {
+		in, out := &in.DefaultMaintenanceWindow, &out.DefaultMaintenanceWindow
+		*out = new(apiscorev1alpha1.MaintenanceWindow)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeConfiguration.
+func (in *UpgradeConfiguration) DeepCopy() *UpgradeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
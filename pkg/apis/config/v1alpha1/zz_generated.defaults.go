@@ -31,6 +31,7 @@ func SetObjectDefaults_LandscaperServiceConfiguration(in *LandscaperServiceConfi
 	SetDefaults_AvailabilityMonitoringConfiguration(&in.AvailabilityMonitoring)
 	SetDefaults_CrdManagementConfiguration(&in.CrdManagement)
 	SetDefaults_ShootConfiguration(&in.ShootConfiguration)
+	SetDefaults_ServiceTargetConfigProbeConfiguration(&in.ServiceTargetConfigProbe)
 }
 
 func SetObjectDefaults_TargetShootSidecarConfiguration(in *TargetShootSidecarConfiguration) {
@@ -13,6 +13,16 @@ const (
 	// LandscaperServiceFinalizer is the finalizer used for landscaper-service objects.
 	LandscaperServiceFinalizer = "finalizer.landscaper-service.gardener.cloud"
 
+	// SubjectListEntryUser is the kind of a subject list entry referencing a user.
+	SubjectListEntryUser = "User"
+	// SubjectListEntryGroup is the kind of a subject list entry referencing a group.
+	SubjectListEntryGroup = "Group"
+	// SubjectListEntryServiceAccount is the kind of a subject list entry referencing a service account.
+	SubjectListEntryServiceAccount = "ServiceAccount"
+
+	// CustomNamespacePrefix is the prefix of the names of namespace registrations and the customer namespaces.
+	CustomNamespacePrefix = "cu-"
+
 	ShootTenantIDLabel          = "shoot.landscaper-service.gardener.cloud/tenantId"
 	ShootInstanceNameLabel      = "shoot.landscaper-service.gardener.cloud/instanceName"
 	ShootInstanceNamespaceLabel = "shoot.landscaper-service.gardener.cloud/instanceNamespace"
@@ -24,8 +34,38 @@ const (
 	// When set at landscaper deployments, the annotation will be inherited to the corresponding instance
 	// and prevents its reconciliation until removed.
 	LandscaperServiceOperationIgnore = "ignore"
+	// LandscaperServiceOperationMigrate can be set as the landscaper service operation annotation at instances.
+	// It allows to change the service target config reference of the instance, which migrates the instance
+	// to the new target cluster. The annotation is removed when the migration has finished.
+	LandscaperServiceOperationMigrate = "migrate"
+	// LandscaperServiceOperationDrain can be set as the landscaper service operation annotation at service target configs.
+	// It cordons the service target config and migrates all its instances to other service target configs, one after the other.
+	// The annotation is removed when no instance is left on the service target config.
+	LandscaperServiceOperationDrain = "drain"
+	// LandscaperServiceOperationRotateCredentials can be set as the landscaper service operation annotation at instances.
+	// It regenerates the service account tokens and kubeconfigs of the instance immediately.
+	// The annotation is removed when the rotation has been initiated.
+	LandscaperServiceOperationRotateCredentials = "rotate-credentials"
 
 	LandscaperServiceOnDeleteStrategyAnnotation                             = "landscaper-service.gardener.cloud/on-delete-strategy"
 	LandscaperServiceOnDeleteStrategyDeleteAllInstallations                 = "delete-all-installations"
 	LandscaperServiceOnDeleteStrategyDeleteAllInstallationsWithoutUninstall = "delete-all-installations-without-uninstall"
+	// LandscaperServiceOnDeleteStrategyOrphanInstances can be set as on-delete-strategy at service target configs.
+	// It allows to delete a service target config which is still used by instances. These instances are marked as orphaned.
+	LandscaperServiceOnDeleteStrategyOrphanInstances = "orphan-instances"
+
+	// LandscaperServiceOrphanedAnnotation is set at instances whose service target config has been deleted.
+	// Its value is the namespaced name of the deleted service target config.
+	// Orphaned instances are not reconciled anymore, and their deletion skips the cleanup on the target cluster.
+	LandscaperServiceOrphanedAnnotation = "landscaper-service.gardener.cloud/orphaned"
+
+	// LandscaperServiceUpgradeApprovedAnnotation is set at instances by the upgrade controller.
+	// Its value is the landscaper service component version to which the instance may be upgraded.
+	// Instances without a selected version are only upgraded to a new default version when the upgrade has been approved.
+	LandscaperServiceUpgradeApprovedAnnotation = "landscaper-service.gardener.cloud/upgrade-approved-version"
+
+	// LandscaperServiceCopiedLabelsAnnotation is set at instances by the landscaper deployment controller.
+	// Its value is the comma-separated list of the label keys, which have been copied from the landscaper deployment.
+	// It allows to remove the labels from the instance, which have been removed from the landscaper deployment.
+	LandscaperServiceCopiedLabelsAnnotation = "landscaper-service.gardener.cloud/copied-labels"
 )
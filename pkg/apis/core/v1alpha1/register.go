@@ -50,6 +50,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SubjectListList{},
 		&TargetScheduling{},
 		&TargetSchedulingList{},
+		&TenantQuota{},
+		&TenantQuotaList{},
+		&RebalancePlan{},
+		&RebalancePlanList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -28,7 +28,12 @@ type InstanceList struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="ServiceTargetConfig",type=string,JSONPath=`.spec.serviceTargetConfigRef.name`
 // +kubebuilder:printcolumn:name="Installation",type=string,JSONPath=`.status.installationRef.name`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.landscaperServiceComponent.version`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Region",type=string,JSONPath=`.status.shootRegion`,priority=1
+// +kubebuilder:printcolumn:name="ShootProfile",type=string,JSONPath=`.status.shootProfile`,priority=1
+// +kubebuilder:printcolumn:name="Hibernated",type=boolean,JSONPath=`.status.hibernated`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type Instance struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -74,6 +79,37 @@ type InstanceSpec struct {
 	// create its own Kubernetes cluster.
 	// +optional
 	DataPlane *DataPlane `json:"dataPlane,omitempty"`
+
+	// Version is the version of the landscaper service component which is installed for this instance.
+	// It must be one of the versions supported by the landscaper service.
+	// If not set, the default version of the landscaper service is used.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// MaintenanceWindow specifies the daily time window in which the instance is upgraded
+	// to a new default version of the landscaper service component.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// UserKubeconfigSecretName is the name of the secret in the namespace of the instance,
+	// into which the user kubeconfig of the landscaper cluster is written.
+	// If not set, the secret is named "<instance name>-user-kubeconfig".
+	// +optional
+	UserKubeconfigSecretName string `json:"userKubeconfigSecretName,omitempty"`
+
+	// Hibernation specifies the hibernation of the instance.
+	// +optional
+	Hibernation *Hibernation `json:"hibernation,omitempty"`
+
+	// Region is the region in which the shoot cluster of the instance must be created.
+	// If not set, the region of the service target config is used.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// ShootProfile selects one of the shoot profiles of the landscaper service for the shoot cluster of the instance.
+	// If not set, the default shoot configuration is used.
+	// +optional
+	ShootProfile string `json:"shootProfile,omitempty"`
 }
 
 // AutomaticReconcile defines the automatic reconcile configuration.
@@ -120,13 +156,25 @@ type InstanceStatus struct {
 	ClusterEndpoint string `json:"clusterEndpoint,omitempty"`
 
 	// UserKubeconfig contains the user kubeconfig which can be used for accessing the landscaper cluster.
+	// Deprecated: the user kubeconfig is written into the secret referenced by UserKubeconfigSecretRef.
+	// The field is only read to migrate existing instances and is cleared afterwards.
 	// +optional
 	UserKubeconfig string `json:"userKubeconfig,omitempty"`
 
 	// AdminKubeconfig contains the admin kubeconfig which can be used for accessing the landscaper cluster.
+	// Deprecated: the admin kubeconfig is written into the secret referenced by AdminKubeconfigSecretRef.
+	// The field is only read to migrate existing instances and is cleared afterwards.
 	// +optional
 	AdminKubeconfig string `json:"adminKubeconfig,omitempty"`
 
+	// UserKubeconfigSecretRef references the secret which contains the user kubeconfig for accessing the landscaper cluster.
+	// +optional
+	UserKubeconfigSecretRef *SecretReference `json:"userKubeconfigSecretRef,omitempty"`
+
+	// AdminKubeconfigSecretRef references the secret which contains the admin kubeconfig for accessing the landscaper cluster.
+	// +optional
+	AdminKubeconfigSecretRef *SecretReference `json:"adminKubeconfigSecretRef,omitempty"`
+
 	// ShootName is the name of the corresponding shoot cluster.
 	// +optional
 	ShootName string `json:"shootName,omitempty"`
@@ -135,6 +183,25 @@ type InstanceStatus struct {
 	// +optional
 	ShootNamespace string `json:"shootNamespace,omitempty"`
 
+	// ShootRegion is the region in which the shoot cluster is created.
+	// The region of a shoot cluster can't be changed, so that it is kept when the instance is migrated.
+	// +optional
+	ShootRegion string `json:"shootRegion,omitempty"`
+
+	// ShootProvider is the infrastructure provider type of the shoot cluster.
+	// Like the region, it is kept when the instance is migrated.
+	// +optional
+	ShootProvider string `json:"shootProvider,omitempty"`
+
+	// ShootProfile is the shoot profile which has been applied to the shoot cluster with the last reconciliation of the installation.
+	// +optional
+	ShootProfile string `json:"shootProfile,omitempty"`
+
+	// ShootKubernetesVersion is the kubernetes version which has been requested for the shoot cluster
+	// with the last reconciliation of the installation.
+	// +optional
+	ShootKubernetesVersion string `json:"shootKubernetesVersion,omitempty"`
+
 	// Reference to the external data plane cluster target.
 	// +optional
 	ExternalDataPlaneClusterRef *ObjectReference `json:"externalDataPlaneClusterRef,omitempty"`
@@ -142,6 +209,117 @@ type InstanceStatus struct {
 	// Phase represents the phase of the corresponding Landscaper Instance Installation phase.
 	// +optional
 	Phase string `json:"phase,omitempty"`
+
+	// ServiceTargetConfigRef references the service target config on which the instance is currently deployed.
+	// It differs from the reference in the spec while the instance is migrated to another target cluster.
+	// +optional
+	ServiceTargetConfigRef *ObjectReference `json:"serviceTargetConfigRef,omitempty"`
+
+	// Migration contains the progress of the last migration of the instance to another target cluster.
+	// +optional
+	Migration *InstanceMigrationStatus `json:"migration,omitempty"`
+
+	// CredentialsRotation contains the progress of the last rotation of the credentials of the instance.
+	// +optional
+	CredentialsRotation *CredentialsRotationStatus `json:"credentialsRotation,omitempty"`
+
+	// Hibernated indicates whether the instance is hibernated.
+	// The landscaper of a hibernated instance is scaled down and its shoot cluster is hibernated.
+	// +optional
+	Hibernated bool `json:"hibernated,omitempty"`
+
+	// Conditions contains the conditions of the resources that are created for this Instance.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+const (
+	// InstanceConditionContextReady indicates whether the landscaper context of the instance has been reconciled.
+	InstanceConditionContextReady = "ContextReady"
+	// InstanceConditionTargetReady indicates whether the targets of the instance have been reconciled.
+	InstanceConditionTargetReady = "TargetReady"
+	// InstanceConditionInstallationSucceeded indicates whether the installation of the instance has succeeded.
+	InstanceConditionInstallationSucceeded = "InstallationSucceeded"
+	// InstanceConditionShootReady indicates whether the shoot cluster of the instance has been created and its
+	// endpoint has been exported. It is only set for instances with an internal data plane.
+	InstanceConditionShootReady = "ShootReady"
+	// InstanceConditionKubeconfigExported indicates whether the user and admin kubeconfigs of the instance have been exported.
+	// It is only set for instances with an internal data plane.
+	InstanceConditionKubeconfigExported = "KubeconfigExported"
+	// InstanceConditionHealthy indicates whether the landscaper of the instance is healthy.
+	// It is maintained by the health watcher for instances contained in an AvailabilityCollection.
+	InstanceConditionHealthy = "Healthy"
+	// InstanceConditionReady indicates whether all other conditions, except Healthy, VersionUpToDate, VersionSupported
+	// and ShootProfileAvailable, are True.
+	InstanceConditionReady = "Ready"
+	// InstanceConditionVersionUpToDate indicates whether the instance runs the landscaper service component version it should run,
+	// or whether an upgrade is pending until it is approved by the upgrade controller.
+	InstanceConditionVersionUpToDate = "VersionUpToDate"
+	// InstanceConditionVersionSupported indicates whether the landscaper service component version of the instance is supported
+	// and not deprecated. It is False with reason VersionDeprecated once the deprecation date of the version has passed.
+	InstanceConditionVersionSupported = "VersionSupported"
+	// InstanceConditionShootProfileAvailable indicates whether the shoot profile selected by the instance is configured.
+	// It is False with reason ShootProfileNotConfigured if the shoot profile has been removed from the landscaper service,
+	// in which case the default shoot configuration is applied. It is only set for instances which select a shoot profile.
+	InstanceConditionShootProfileAvailable = "ShootProfileAvailable"
+)
+
+// InstanceMigrationPhase is the phase of an instance migration.
+type InstanceMigrationPhase string
+
+const (
+	// InstanceMigrationPhaseInstalling means that the landscaper is being installed on the destination target cluster.
+	InstanceMigrationPhaseInstalling InstanceMigrationPhase = "Installing"
+	// InstanceMigrationPhaseCleaningUp means that the landscaper is being removed from the source target cluster.
+	InstanceMigrationPhaseCleaningUp InstanceMigrationPhase = "CleaningUp"
+	// InstanceMigrationPhaseSucceeded means that the migration has finished.
+	InstanceMigrationPhaseSucceeded InstanceMigrationPhase = "Succeeded"
+)
+
+// InstanceMigrationStatus contains the progress of the migration of an instance to another target cluster.
+type InstanceMigrationStatus struct {
+	// Source references the service target config from which the instance is migrated.
+	Source ObjectReference `json:"source"`
+
+	// Destination references the service target config to which the instance is migrated.
+	Destination ObjectReference `json:"destination"`
+
+	// Phase is the current phase of the migration.
+	Phase InstanceMigrationPhase `json:"phase"`
+
+	// StartTime is the point in time at which the migration has been started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is the point in time at which the migration has finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message describes the current state of the migration.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CredentialsRotationStatus contains the progress of the rotation of the credentials of an instance.
+type CredentialsRotationStatus struct {
+	// LastInitiationTime is the point in time at which the last rotation of the credentials has been initiated.
+	LastInitiationTime metav1.Time `json:"lastInitiationTime"`
+
+	// LastCompletionTime is the point in time at which the last rotation of the credentials has finished.
+	// +optional
+	LastCompletionTime *metav1.Time `json:"lastCompletionTime,omitempty"`
+}
+
+// IsRotatingCredentials returns true if a rotation of the credentials of the instance has been initiated, but not yet finished.
+func (ld *Instance) IsRotatingCredentials() bool {
+	rotation := ld.Status.CredentialsRotation
+	return rotation != nil && (rotation.LastCompletionTime == nil || rotation.LastCompletionTime.Before(&rotation.LastInitiationTime))
+}
+
+// IsMigrating returns true if the instance is being migrated to another service target config.
+func (ld *Instance) IsMigrating() bool {
+	return ld.Status.ServiceTargetConfigRef != nil && !ld.Status.ServiceTargetConfigRef.Equals(&ld.Spec.ServiceTargetConfigRef)
 }
 
 func (ld *Instance) IsExternalDataPlane() bool {
@@ -31,6 +31,7 @@ type LandscaperDeploymentList struct {
 // +kubebuilder:printcolumn:name="DataPlaneType",type=string,JSONPath=`.status.dataPlaneType`
 // +kubebuilder:printcolumn:name="Instance",type=string,JSONPath=`.status.instanceRef.name`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type LandscaperDeployment struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -69,6 +70,45 @@ type LandscaperDeploymentSpec struct {
 	// create its own Kubernetes cluster.
 	// +optional
 	DataPlane *DataPlane `json:"dataPlane,omitempty"`
+
+	// Version is the version of the landscaper service component which is installed for this deployment.
+	// It must be one of the versions supported by the landscaper service.
+	// If not set, the default version of the landscaper service is used.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// MaintenanceWindow specifies the daily time window in which the instance of this deployment is upgraded
+	// to a new default version of the landscaper service component.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// UserKubeconfigSecretName is the name of the secret in the namespace of this deployment,
+	// into which the user kubeconfig of the landscaper cluster is written.
+	// If not set, the secret is named "<instance name>-user-kubeconfig".
+	// +optional
+	UserKubeconfigSecretName string `json:"userKubeconfigSecretName,omitempty"`
+
+	// Hibernation specifies the hibernation of the instance of this deployment.
+	// +optional
+	Hibernation *Hibernation `json:"hibernation,omitempty"`
+
+	// SchedulingConstraints control the placement of the instance of this deployment on a ServiceTargetConfig,
+	// in addition to the constraints of the applied TargetScheduling rules.
+	// +optional
+	SchedulingConstraints *SchedulingConstraints `json:"schedulingConstraints,omitempty"`
+
+	// Region is the region in which the shoot cluster of the instance of this deployment must be created,
+	// e.g. because of data residency requirements of the tenant.
+	// The instance is only scheduled on ServiceTargetConfigs of this region. The region is immutable.
+	// If not set, the region of the selected ServiceTargetConfig is used.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// ShootProfile selects one of the shoot profiles of the landscaper service for the shoot cluster of the instance of this deployment,
+	// e.g. a profile with bigger worker nodes for heavy deploy loads. If not set, the default shoot configuration is used.
+	// The shoot profile is immutable and can't be used in combination with an external data plane.
+	// +optional
+	ShootProfile string `json:"shootProfile,omitempty"`
 }
 
 // LandscaperDeploymentStatus contains the status of a LandscaperDeployment.
@@ -93,6 +133,93 @@ type LandscaperDeploymentStatus struct {
 	// DataPlaneType shows whether this deployment has an internal or external data plane cluster.
 	// +optional
 	DataPlaneType string `json:"dataPlaneType,omitempty"`
+
+	// SchedulingDecision records how the ServiceTargetConfig of the instance has been selected.
+	// +optional
+	SchedulingDecision *SchedulingDecision `json:"schedulingDecision,omitempty"`
+
+	// Conditions contains the conditions of the LandscaperDeployment.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+const (
+	// LandscaperDeploymentConditionScheduled indicates whether a ServiceTargetConfig has been selected for the instance.
+	LandscaperDeploymentConditionScheduled = "Scheduled"
+	// LandscaperDeploymentConditionReady mirrors the Ready condition of the instance.
+	LandscaperDeploymentConditionReady = "Ready"
+	// LandscaperDeploymentConditionHealthy mirrors the Healthy condition of the instance.
+	LandscaperDeploymentConditionHealthy = "Healthy"
+)
+
+// SchedulingDecision records the result of the target scheduling of a LandscaperDeployment.
+type SchedulingDecision struct {
+	// Time is the point in time at which the scheduling decision was made.
+	Time metav1.Time `json:"time"`
+
+	// MatchedRules is the list of TargetScheduling rules whose selector matched the LandscaperDeployment,
+	// together with the rules with a lower priority than the applied rules, whose selector couldn't be evaluated.
+	// +optional
+	MatchedRules []MatchedSchedulingRule `json:"matchedRules,omitempty"`
+
+	// Candidates is the list of ServiceTargetConfigs which have been considered.
+	// +optional
+	Candidates []SchedulingCandidate `json:"candidates,omitempty"`
+
+	// Selected references the ServiceTargetConfig which has been selected.
+	// It is not set if the scheduling failed.
+	// +optional
+	Selected *ObjectReference `json:"selected,omitempty"`
+
+	// Message is a human-readable summary of the scheduling decision.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// MatchedSchedulingRule describes a TargetScheduling rule that matched a LandscaperDeployment.
+type MatchedSchedulingRule struct {
+	// Scheduling references the TargetScheduling, which contains the rule.
+	// +optional
+	Scheduling *ObjectReference `json:"scheduling,omitempty"`
+
+	// Index is the index of the rule in the list of rules of the TargetScheduling.
+	Index int `json:"index"`
+
+	// Priority is the priority of the rule.
+	Priority int64 `json:"priority"`
+
+	// Applied is true if the rule has been used to determine the candidates, i.e. it has the highest priority
+	// of all matching rules.
+	Applied bool `json:"applied"`
+
+	// Error is the error which occurred during the evaluation of the selector of the rule.
+	// Such a rule is only recorded, if a rule with a higher priority has been applied.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// SchedulingCandidate describes a ServiceTargetConfig which has been considered during the target scheduling.
+type SchedulingCandidate struct {
+	// ServiceTargetConfig references the candidate.
+	ServiceTargetConfig ObjectReference `json:"serviceTargetConfig"`
+
+	// Priority is the priority of the ServiceTargetConfig.
+	// +optional
+	Priority int64 `json:"priority,omitempty"`
+
+	// InstanceCount is the number of instances scheduled on the ServiceTargetConfig at the time of the decision.
+	// +optional
+	InstanceCount int `json:"instanceCount,omitempty"`
+
+	// Score is the effective priority, i.e. the priority divided by the number of instances + 1.
+	// +optional
+	Score string `json:"score,omitempty"`
+
+	// FilterReason describes why the candidate has been filtered out. It is empty for eligible candidates.
+	// +optional
+	FilterReason string `json:"filterReason,omitempty"`
 }
 
 func (ld *LandscaperDeployment) IsExternalDataPlane() bool {
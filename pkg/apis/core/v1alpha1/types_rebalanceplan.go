@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RebalancePlanList contains a list of RebalancePlan
+type RebalancePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RebalancePlan `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RebalancePlan evaluates the placement of the existing instances against the current TargetSchedulings
+// and ServiceTargetConfig priorities, and proposes instance migrations which improve the distribution.
+// The proposed migrations are only executed after the plan has been approved.
+// +kubebuilder:resource:singular="rebalanceplan",path="rebalanceplans",shortName="rbp",scope="Namespaced"
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Approved",type=boolean,JSONPath=`.spec.approved`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type RebalancePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification for the RebalancePlan
+	Spec RebalancePlanSpec `json:"spec"`
+
+	// Status contains the status of the RebalancePlan.
+	// +optional
+	Status RebalancePlanStatus `json:"status"`
+}
+
+// RebalancePlanSpec contains the specification for a RebalancePlan.
+type RebalancePlanSpec struct {
+	// ServiceTargetConfigs restricts the plan to the instances deployed on these ServiceTargetConfigs.
+	// If empty, the instances on all ServiceTargetConfigs are evaluated.
+	// +optional
+	ServiceTargetConfigs []ObjectReference `json:"serviceTargetConfigs,omitempty"`
+
+	// MaxMoves is the maximum number of proposed instance migrations. If not set, the number is not limited.
+	// +optional
+	MaxMoves *int `json:"maxMoves,omitempty"`
+
+	// Approved starts the execution of the proposed migrations.
+	// The approval only takes effect if it is given after the plan has been computed.
+	// The plan is not computed again once it has been approved. Revoking the approval pauses the execution.
+	// +optional
+	Approved bool `json:"approved,omitempty"`
+}
+
+// RebalancePlanPhase is the phase of a RebalancePlan.
+type RebalancePlanPhase string
+
+const (
+	// RebalancePlanPhasePlanned means that the migrations have been proposed and are waiting for the approval.
+	RebalancePlanPhasePlanned RebalancePlanPhase = "Planned"
+	// RebalancePlanPhaseExecuting means that the approved migrations are being executed.
+	RebalancePlanPhaseExecuting RebalancePlanPhase = "Executing"
+	// RebalancePlanPhaseCompleted means that all approved migrations have been finished or skipped.
+	RebalancePlanPhaseCompleted RebalancePlanPhase = "Completed"
+)
+
+// RebalancePlanStatus contains the status of a RebalancePlan.
+type RebalancePlanStatus struct {
+	// ObservedGeneration is the most recent generation for which the plan has been computed or executed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// LastError describes the last error that occurred.
+	// +optional
+	LastError *Error `json:"lastError,omitempty"`
+
+	// Phase is the current phase of the RebalancePlan.
+	// +optional
+	Phase RebalancePlanPhase `json:"phase,omitempty"`
+
+	// PlanTime is the time when the migrations have been proposed.
+	// +optional
+	PlanTime *metav1.Time `json:"planTime,omitempty"`
+
+	// CompletionTime is the time when the execution of the migrations has been completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Moves are the proposed instance migrations, in the order in which they are executed.
+	// +optional
+	Moves []RebalanceMove `json:"moves,omitempty"`
+
+	// Message describes the current state of the RebalancePlan.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RebalanceMovePhase is the phase of a single migration of a RebalancePlan.
+type RebalanceMovePhase string
+
+const (
+	// RebalanceMovePhaseProposed means that the migration has not yet been started.
+	RebalanceMovePhaseProposed RebalanceMovePhase = "Proposed"
+	// RebalanceMovePhaseMigrating means that the instance is being migrated.
+	RebalanceMovePhaseMigrating RebalanceMovePhase = "Migrating"
+	// RebalanceMovePhaseSucceeded means that the instance has been migrated to the destination.
+	RebalanceMovePhaseSucceeded RebalanceMovePhase = "Succeeded"
+	// RebalanceMovePhaseSkipped means that the migration has not been executed, because the plan is outdated for this instance.
+	RebalanceMovePhaseSkipped RebalanceMovePhase = "Skipped"
+)
+
+// RebalanceMove is a proposed migration of an instance to another ServiceTargetConfig.
+type RebalanceMove struct {
+	// Instance references the instance to migrate.
+	Instance ObjectReference `json:"instance"`
+
+	// Deployment references the LandscaperDeployment which owns the instance.
+	Deployment ObjectReference `json:"deployment"`
+
+	// TenantID is the tenant of the instance.
+	TenantID string `json:"tenantId"`
+
+	// Source references the ServiceTargetConfig on which the instance is deployed.
+	Source ObjectReference `json:"source"`
+
+	// Destination references the ServiceTargetConfig to which the instance is migrated.
+	Destination ObjectReference `json:"destination"`
+
+	// Reason describes why the migration has been proposed.
+	Reason string `json:"reason"`
+
+	// Phase is the current phase of the migration.
+	Phase RebalanceMovePhase `json:"phase"`
+
+	// Message describes why the migration has been skipped.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
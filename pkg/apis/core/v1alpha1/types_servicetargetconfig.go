@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -25,6 +26,12 @@ type ServiceTargetConfigList struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Visible",type=string,JSONPath=`.metadata.labels.config\.landscaper-service\.gardener\.cloud/visible`
 // +kubebuilder:printcolumn:name="Priority",type=number,JSONPath=`.spec.priority`
+// +kubebuilder:printcolumn:name="MaxInstances",type=number,JSONPath=`.spec.maxInstances`
+// +kubebuilder:printcolumn:name="Region",type=string,JSONPath=`.spec.region`
+// +kubebuilder:printcolumn:name="Provider",type=string,JSONPath=`.spec.provider`,priority=1
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Cordoned",type=boolean,JSONPath=`.spec.cordoned`
+// +kubebuilder:printcolumn:name="Drain",type=string,JSONPath=`.status.drain.phase`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type ServiceTargetConfig struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -54,6 +61,30 @@ type ServiceTargetConfigSpec struct {
 
 	// IngressDomain is the ingress domain of the corresponding target cluster.
 	IngressDomain string `json:"ingressDomain"`
+
+	// MaxInstances is the maximum number of instances that can be scheduled on this ServiceTargetConfig.
+	// If not set, the number of instances is not limited.
+	// +optional
+	MaxInstances *int64 `json:"maxInstances,omitempty"`
+
+	// MaxInstancesPerTenant is the maximum number of instances of a single tenant that can be scheduled on this ServiceTargetConfig.
+	// If not set, the number of instances per tenant is not limited.
+	// +optional
+	MaxInstancesPerTenant *int64 `json:"maxInstancesPerTenant,omitempty"`
+
+	// Cordoned excludes the ServiceTargetConfig from the scheduling of new instances.
+	// Instances which are already deployed on the target cluster are not affected.
+	// +optional
+	Cordoned bool `json:"cordoned,omitempty"`
+
+	// Region is the region in which the shoot clusters of the instances of this ServiceTargetConfig are created.
+	// Deployments which request a region are only scheduled on ServiceTargetConfigs of that region.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Provider is the infrastructure provider type of the shoot clusters of the instances of this ServiceTargetConfig, e.g. "gcp".
+	// +optional
+	Provider string `json:"provider,omitempty"`
 }
 
 // ServiceTargetConfigStatus contains the status of a ServiceTargetConfig.
@@ -63,7 +94,109 @@ type ServiceTargetConfigStatus struct {
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration"`
 
+	// LastError describes the last error that occurred.
+	// +optional
+	LastError *Error `json:"lastError,omitempty"`
+
+	// Conditions contains the results of the last probe of the target cluster.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastProbeTime is the time when the target cluster has been probed the last time.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// KubernetesVersion is the kubernetes version of the target cluster, as reported by the last probe.
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// Usage contains the aggregated usage of the target cluster by the instances of this ServiceTargetConfig.
+	// +optional
+	Usage *ServiceTargetConfigUsage `json:"usage,omitempty"`
+
 	// InstanceRefs is the list of references to instances that use this ServiceTargetConfig.
 	// +optional
 	InstanceRefs []ObjectReference `json:"instanceRefs,omitempty"`
+
+	// Drain contains the progress of the drain operation, if the ServiceTargetConfig is or has been drained.
+	// +optional
+	Drain *ServiceTargetConfigDrainStatus `json:"drain,omitempty"`
+}
+
+// ServiceTargetConfigUsage contains the aggregated usage of the target cluster of a ServiceTargetConfig.
+type ServiceTargetConfigUsage struct {
+	// InstanceCount is the number of instances which are deployed on the target cluster.
+	InstanceCount int `json:"instanceCount"`
+
+	// InstancesByPhase is the number of instances per instance phase.
+	// +optional
+	InstancesByPhase map[string]int `json:"instancesByPhase,omitempty"`
+
+	// RequestedCPU is the sum of the cpu requests of the landscaper resources of all instances.
+	// +optional
+	RequestedCPU *resource.Quantity `json:"requestedCPU,omitempty"`
+
+	// RequestedMemory is the sum of the memory requests of the landscaper resources of all instances.
+	// +optional
+	RequestedMemory *resource.Quantity `json:"requestedMemory,omitempty"`
+
+	// NodeCount is the number of nodes of the target cluster, as reported by the last probe.
+	// +optional
+	NodeCount *int `json:"nodeCount,omitempty"`
+
+	// AllocatableCPU is the sum of the allocatable cpu of all nodes of the target cluster, as reported by the last probe.
+	// +optional
+	AllocatableCPU *resource.Quantity `json:"allocatableCPU,omitempty"`
+
+	// AllocatableMemory is the sum of the allocatable memory of all nodes of the target cluster, as reported by the last probe.
+	// +optional
+	AllocatableMemory *resource.Quantity `json:"allocatableMemory,omitempty"`
+}
+
+const (
+	// ServiceTargetConfigConditionReachable indicates whether the API server of the target cluster is reachable.
+	ServiceTargetConfigConditionReachable = "Reachable"
+	// ServiceTargetConfigConditionCredentialsValid indicates whether the credentials of the kubeconfig allow
+	// to create the resources that are needed to deploy instances on the target cluster.
+	ServiceTargetConfigConditionCredentialsValid = "CredentialsValid"
+	// ServiceTargetConfigConditionReady indicates whether new instances can be deployed on the target cluster.
+	// ServiceTargetConfigs with a Ready condition of status False are skipped by the scheduling.
+	ServiceTargetConfigConditionReady = "Ready"
+)
+
+// ServiceTargetConfigDrainPhase is the phase of the drain operation of a ServiceTargetConfig.
+type ServiceTargetConfigDrainPhase string
+
+const (
+	// ServiceTargetConfigDrainPhaseDraining means that the instances are being migrated to other ServiceTargetConfigs.
+	ServiceTargetConfigDrainPhaseDraining ServiceTargetConfigDrainPhase = "Draining"
+	// ServiceTargetConfigDrainPhaseDrained means that no instance is deployed on the ServiceTargetConfig anymore.
+	ServiceTargetConfigDrainPhaseDrained ServiceTargetConfigDrainPhase = "Drained"
+)
+
+// ServiceTargetConfigDrainStatus contains the progress of the drain operation of a ServiceTargetConfig.
+type ServiceTargetConfigDrainStatus struct {
+	// Phase is the current phase of the drain operation.
+	Phase ServiceTargetConfigDrainPhase `json:"phase"`
+
+	// StartTime is the time when the drain operation has been started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is the time when the last instance has been migrated.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// RemainingInstances are the instances which are still deployed on the ServiceTargetConfig.
+	// +optional
+	RemainingInstances []ObjectReference `json:"remainingInstances,omitempty"`
+
+	// MigratingInstance is the instance which is currently being migrated to another ServiceTargetConfig.
+	// +optional
+	MigratingInstance *ObjectReference `json:"migratingInstance,omitempty"`
+
+	// Message is a human-readable description of the current state of the drain operation.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
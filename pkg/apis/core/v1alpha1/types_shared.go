@@ -162,6 +162,48 @@ type LandscaperServiceComponent struct {
 
 	// Version defines the version of the landscaper service component.
 	Version string `json:"version"`
+
+	// DeprecationDate is the date since which the version of the landscaper service component is deprecated.
+	// +optional
+	DeprecationDate *metav1.Time `json:"deprecationDate,omitempty"`
+}
+
+// MaintenanceWindow specifies a daily time window.
+type MaintenanceWindow struct {
+	// Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. "220000+0100".
+	Begin string `json:"begin"`
+
+	// End is the end of the time window in the format HHMMSS+ZONE, e.g. "230000+0100".
+	End string `json:"end"`
+}
+
+// Hibernation specifies the hibernation of a landscaper instance.
+// A hibernated instance has no running landscaper and, for an internal data plane, a hibernated shoot cluster.
+type Hibernation struct {
+	// Enabled hibernates (true) or wakes up (false) the instance, independent of the schedules.
+	// If not set, the instance is hibernated according to the schedules.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Schedules specify the periods in which the instance is hibernated.
+	// +optional
+	Schedules []HibernationSchedule `json:"schedules,omitempty"`
+}
+
+// HibernationSchedule specifies a recurring period in which an instance is hibernated.
+type HibernationSchedule struct {
+	// Start is a cron expression in the format "minute hour day-of-month month day-of-week",
+	// specifying when the instance is hibernated, e.g. "0 20 * * MON-FRI".
+	Start string `json:"start"`
+
+	// End is a cron expression in the format "minute hour day-of-month month day-of-week",
+	// specifying when the instance is woken up, e.g. "0 6 * * MON-FRI".
+	End string `json:"end"`
+
+	// Location is the time zone in which the cron expressions are evaluated, e.g. "Europe/Berlin".
+	// If not set, UTC is used.
+	// +optional
+	Location string `json:"location,omitempty"`
 }
 
 // OIDCConfig defines the OIDC configuration
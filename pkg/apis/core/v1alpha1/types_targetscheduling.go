@@ -20,18 +20,50 @@ type TargetSchedulingList struct {
 // TargetScheduling defines the rules according to which a LandscaperDeployment is assigned a ServiceTargetConfig.
 // +kubebuilder:resource:singular="targetscheduling",path="targetschedulings",shortName="ts",scope="Namespaced"
 // +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Active",type=boolean,JSONPath=`.status.active`
+// +kubebuilder:printcolumn:name="Placed",type=integer,JSONPath=`.status.placedDeployments`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type TargetScheduling struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	// Spec contains the specification for the Scheduling
 	Spec TargetSchedulingSpec `json:"spec"`
+
+	// Status contains the status for the Scheduling.
+	// +optional
+	Status TargetSchedulingStatus `json:"status"`
 }
 
 type TargetSchedulingSpec struct {
 	Rules []SchedulingRule `json:"rules,omitempty"`
 }
 
+// TargetSchedulingStatus contains the status for a TargetScheduling.
+type TargetSchedulingStatus struct {
+	// ObservedGeneration is the most recent generation observed for this TargetScheduling.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// LastError describes the last error that occurred.
+	// +optional
+	LastError *Error `json:"lastError,omitempty"`
+
+	// Active is true if the rules of this TargetScheduling are used for the scheduling of LandscaperDeployments,
+	// i.e. it is referenced by the landscaper service configuration or matches the configured scheduling selector.
+	// +optional
+	Active bool `json:"active"`
+
+	// PlacedDeployments is the number of LandscaperDeployments, which have been placed by a rule of this TargetScheduling.
+	// +optional
+	PlacedDeployments int `json:"placedDeployments"`
+
+	// Conflicts describes the rules of this TargetScheduling, which conflict with rules of other active TargetSchedulings.
+	// +optional
+	Conflicts []string `json:"conflicts,omitempty"`
+}
+
 type SchedulingRule struct {
 
 	// The Priority of this SchedulingRule.
@@ -41,6 +73,11 @@ type SchedulingRule struct {
 	ServiceTargetConfigs []ObjectReference `json:"serviceTargetConfigs,omitempty"`
 
 	Selector []Selector `json:"selector,omitempty"`
+
+	// Constraints control the distribution of the LandscaperDeployments, to which this rule is applied,
+	// over the ServiceTargetConfigs of the rule.
+	// +optional
+	Constraints *SchedulingConstraints `json:"constraints,omitempty"`
 }
 
 type Selector struct {
@@ -51,6 +88,22 @@ type Selector struct {
 	// +optional
 	MatchLabel *LabelSelector `json:"matchLabel,omitempty"`
 
+	// MatchPurpose matches the purpose of a LandscaperDeployment.
+	// +optional
+	MatchPurpose *StringSelector `json:"matchPurpose,omitempty"`
+
+	// MatchNamespace matches the namespace of a LandscaperDeployment.
+	// +optional
+	MatchNamespace *StringSelector `json:"matchNamespace,omitempty"`
+
+	// MatchHighAvailability matches the high availability configuration of a LandscaperDeployment.
+	// +optional
+	MatchHighAvailability *HighAvailabilitySelector `json:"matchHighAvailability,omitempty"`
+
+	// MatchDataPlane matches the data plane type of a LandscaperDeployment.
+	// +optional
+	MatchDataPlane *DataPlaneSelector `json:"matchDataPlane,omitempty"`
+
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Schemaless
 	// +optional
@@ -67,11 +120,142 @@ type Selector struct {
 	Not *Selector `json:"not,omitempty"`
 }
 
+// TenantSelector matches the tenant id of a LandscaperDeployment.
+// Exactly one of the fields must be set.
 type TenantSelector struct {
+	// ID matches exactly the given tenant id.
+	// +optional
 	ID string `json:"id,omitempty"`
+
+	// IDs matches any of the given tenant ids.
+	// +optional
+	IDs []string `json:"ids,omitempty"`
+
+	// Prefix matches tenant ids starting with the given prefix.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Regex matches tenant ids matching the given regular expression.
+	// +optional
+	Regex string `json:"regex,omitempty"`
 }
 
+// LabelSelectorOperator is the operator of a LabelSelector.
+type LabelSelectorOperator string
+
+const (
+	// LabelSelectorOpIn matches labels whose value is contained in the values of the selector.
+	LabelSelectorOpIn LabelSelectorOperator = "In"
+	// LabelSelectorOpNotIn matches, if the label doesn't exist or its value is not contained in the values of the selector.
+	LabelSelectorOpNotIn LabelSelectorOperator = "NotIn"
+	// LabelSelectorOpExists matches, if the label exists.
+	LabelSelectorOpExists LabelSelectorOperator = "Exists"
+	// LabelSelectorOpDoesNotExist matches, if the label doesn't exist.
+	LabelSelectorOpDoesNotExist LabelSelectorOperator = "DoesNotExist"
+)
+
+// LabelSelector matches a label of a LandscaperDeployment.
+// Without operator, the label must have the given value.
 type LabelSelector struct {
 	Name  string `json:"name,omitempty"`
 	Value string `json:"value,omitempty"`
+
+	// Operator is one of "In", "NotIn", "Exists" and "DoesNotExist".
+	// +optional
+	Operator LabelSelectorOperator `json:"operator,omitempty"`
+
+	// Values are the label values used by the operators "In" and "NotIn".
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// StringSelector matches a string field of a LandscaperDeployment.
+// Exactly one of the fields must be set.
+type StringSelector struct {
+	// Values matches any of the given values.
+	// +optional
+	Values []string `json:"values,omitempty"`
+
+	// Prefix matches values starting with the given prefix.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Regex matches values matching the given regular expression.
+	// +optional
+	Regex string `json:"regex,omitempty"`
+}
+
+// HighAvailabilitySelector matches the high availability configuration of a LandscaperDeployment.
+type HighAvailabilitySelector struct {
+	// Enabled matches LandscaperDeployments with (true) or without (false) high availability configuration.
+	Enabled bool `json:"enabled"`
+
+	// ControlPlaneFailureTolerance additionally matches the control plane failure tolerance ("node" or "zone")
+	// of LandscaperDeployments with high availability configuration.
+	// +optional
+	ControlPlaneFailureTolerance string `json:"controlPlaneFailureTolerance,omitempty"`
+}
+
+// DataPlaneSelector matches the data plane type of a LandscaperDeployment.
+type DataPlaneSelector struct {
+	// Type is the data plane type, either "Internal" or "External".
+	Type string `json:"type"`
+}
+
+// UnsatisfiableConstraintAction defines how a scheduling constraint is handled, if it cannot be satisfied.
+type UnsatisfiableConstraintAction string
+
+const (
+	// DoNotSchedule removes the ServiceTargetConfigs which violate the constraint from the candidates.
+	DoNotSchedule UnsatisfiableConstraintAction = "DoNotSchedule"
+	// ScheduleAnyway prefers the ServiceTargetConfigs which satisfy the constraint,
+	// but still selects a violating ServiceTargetConfig if there is no other candidate.
+	ScheduleAnyway UnsatisfiableConstraintAction = "ScheduleAnyway"
+)
+
+// SchedulingConstraints control the distribution of LandscaperDeployments over the ServiceTargetConfigs.
+type SchedulingConstraints struct {
+	// SpreadConstraints spread the LandscaperDeployments of a tenant over the ServiceTargetConfigs or topology domains.
+	// +optional
+	SpreadConstraints []SpreadConstraint `json:"spreadConstraints,omitempty"`
+
+	// AntiAffinity keeps LandscaperDeployments which share a label on different ServiceTargetConfigs or topology domains.
+	// +optional
+	AntiAffinity []AntiAffinityTerm `json:"antiAffinity,omitempty"`
+}
+
+// SpreadConstraint limits the difference between the number of instances of a tenant in the topology domains.
+type SpreadConstraint struct {
+	// TopologyKey is the key of a label of the ServiceTargetConfigs. ServiceTargetConfigs with the same value
+	// of this label belong to the same topology domain, e.g. a region or zone.
+	// If not set, or if a ServiceTargetConfig does not have the label, the ServiceTargetConfig is a domain of its own.
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+
+	// MaxSkew is the maximum permitted difference between the number of instances of the tenant in a topology domain
+	// and the minimum number of instances of the tenant in any of the candidate topology domains. Defaults to 1.
+	// +optional
+	MaxSkew int `json:"maxSkew,omitempty"`
+
+	// WhenUnsatisfiable is either "DoNotSchedule" (default) or "ScheduleAnyway".
+	// +optional
+	WhenUnsatisfiable UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
+
+// AntiAffinityTerm keeps a LandscaperDeployment away from the topology domains of the other LandscaperDeployments,
+// which have the same value of a label.
+type AntiAffinityTerm struct {
+	// LabelKey is the key of a label of the LandscaperDeployments. The term only applies to LandscaperDeployments
+	// which have this label.
+	LabelKey string `json:"labelKey"`
+
+	// TopologyKey is the key of a label of the ServiceTargetConfigs. ServiceTargetConfigs with the same value
+	// of this label belong to the same topology domain, e.g. a region or zone.
+	// If not set, or if a ServiceTargetConfig does not have the label, the ServiceTargetConfig is a domain of its own.
+	// +optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+
+	// WhenUnsatisfiable is either "DoNotSchedule" (default) or "ScheduleAnyway".
+	// +optional
+	WhenUnsatisfiable UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
 }
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TenantQuotaList contains a list of TenantQuota
+type TenantQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TenantQuota `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// The TenantQuota limits the LandscaperDeployments of a tenant.
+// The limits are enforced by the validation webhook, when a LandscaperDeployment of the tenant is created or updated.
+// The LandscaperDeployments of the tenant in all namespaces are taken into account.
+// +kubebuilder:resource:singular="tenantquota",path="tenantquotas",shortName="tq",scope="Namespaced"
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Tenant",type=string,JSONPath=`.spec.tenantId`
+// +kubebuilder:printcolumn:name="Instances",type=integer,JSONPath=`.status.used.instances`
+// +kubebuilder:printcolumn:name="InstancesLimit",type=integer,JSONPath=`.spec.limits.instances`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type TenantQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification for the TenantQuota.
+	Spec TenantQuotaSpec `json:"spec"`
+
+	// Status contains the status for the TenantQuota.
+	// +optional
+	Status TenantQuotaStatus `json:"status"`
+}
+
+// TenantQuotaSpec contains the specification for a TenantQuota.
+type TenantQuotaSpec struct {
+	// TenantId is the id of the tenant, whose LandscaperDeployments are limited.
+	TenantId string `json:"tenantId"`
+
+	// Limits are the limits for the LandscaperDeployments of the tenant.
+	Limits TenantQuotaResources `json:"limits"`
+}
+
+// TenantQuotaResources contains the resources of a tenant, which can be limited.
+type TenantQuotaResources struct {
+	// Instances is the number of LandscaperDeployments.
+	// +optional
+	Instances *int `json:"instances,omitempty"`
+
+	// HighAvailabilityInstances is the number of LandscaperDeployments with a high availability config.
+	// +optional
+	HighAvailabilityInstances *int `json:"highAvailabilityInstances,omitempty"`
+
+	// RequestedCPU is the sum of the cpu requests of the landscaper resources of all LandscaperDeployments.
+	// +optional
+	RequestedCPU *resource.Quantity `json:"requestedCPU,omitempty"`
+
+	// RequestedMemory is the sum of the memory requests of the landscaper resources of all LandscaperDeployments.
+	// +optional
+	RequestedMemory *resource.Quantity `json:"requestedMemory,omitempty"`
+}
+
+// TenantQuotaStatus contains the status for a TenantQuota.
+type TenantQuotaStatus struct {
+	// ObservedGeneration is the most recent generation observed for this TenantQuota.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// LastError describes the last error that occurred.
+	// +optional
+	LastError *Error `json:"lastError,omitempty"`
+
+	// Used is the current usage of the tenant.
+	// +optional
+	Used TenantQuotaResources `json:"used"`
+}
@@ -10,9 +10,26 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AntiAffinityTerm) DeepCopyInto(out *AntiAffinityTerm) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AntiAffinityTerm.
+func (in *AntiAffinityTerm) DeepCopy() *AntiAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(AntiAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutomaticReconcile) DeepCopyInto(out *AutomaticReconcile) {
 	*out = *in
@@ -201,6 +218,27 @@ func (in *Controllers) DeepCopy() *Controllers {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsRotationStatus) DeepCopyInto(out *CredentialsRotationStatus) {
+	*out = *in
+	in.LastInitiationTime.DeepCopyInto(&out.LastInitiationTime)
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsRotationStatus.
+func (in *CredentialsRotationStatus) DeepCopy() *CredentialsRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataPlane) DeepCopyInto(out *DataPlane) {
 	*out = *in
@@ -222,6 +260,22 @@ func (in *DataPlane) DeepCopy() *DataPlane {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneSelector) DeepCopyInto(out *DataPlaneSelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneSelector.
+func (in *DataPlaneSelector) DeepCopy() *DataPlaneSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemTimeouts) DeepCopyInto(out *DeployItemTimeouts) {
 	*out = *in
@@ -329,6 +383,48 @@ func (in *HPA) DeepCopy() *HPA {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hibernation) DeepCopyInto(out *Hibernation) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]HibernationSchedule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hibernation.
+func (in *Hibernation) DeepCopy() *Hibernation {
+	if in == nil {
+		return nil
+	}
+	out := new(Hibernation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationSchedule.
+func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HighAvailabilityConfig) DeepCopyInto(out *HighAvailabilityConfig) {
 	*out = *in
@@ -345,6 +441,22 @@ func (in *HighAvailabilityConfig) DeepCopy() *HighAvailabilityConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HighAvailabilitySelector) DeepCopyInto(out *HighAvailabilitySelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailabilitySelector.
+func (in *HighAvailabilitySelector) DeepCopy() *HighAvailabilitySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(HighAvailabilitySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Instance) DeepCopyInto(out *Instance) {
 	*out = *in
@@ -406,6 +518,29 @@ func (in *InstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMigrationStatus) DeepCopyInto(out *InstanceMigrationStatus) {
+	*out = *in
+	out.Source = in.Source
+	out.Destination = in.Destination
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMigrationStatus.
+func (in *InstanceMigrationStatus) DeepCopy() *InstanceMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 	*out = *in
@@ -431,6 +566,16 @@ func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 		*out = new(DataPlane)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(Hibernation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -455,7 +600,7 @@ func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
 	if in.LandscaperServiceComponent != nil {
 		in, out := &in.LandscaperServiceComponent, &out.LandscaperServiceComponent
 		*out = new(LandscaperServiceComponent)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ContextRef != nil {
 		in, out := &in.ContextRef, &out.ContextRef
@@ -477,11 +622,43 @@ func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
 		*out = new(ObjectReference)
 		**out = **in
 	}
+	if in.UserKubeconfigSecretRef != nil {
+		in, out := &in.UserKubeconfigSecretRef, &out.UserKubeconfigSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.AdminKubeconfigSecretRef != nil {
+		in, out := &in.AdminKubeconfigSecretRef, &out.AdminKubeconfigSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
 	if in.ExternalDataPlaneClusterRef != nil {
 		in, out := &in.ExternalDataPlaneClusterRef, &out.ExternalDataPlaneClusterRef
 		*out = new(ObjectReference)
 		**out = **in
 	}
+	if in.ServiceTargetConfigRef != nil {
+		in, out := &in.ServiceTargetConfigRef, &out.ServiceTargetConfigRef
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(InstanceMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsRotation != nil {
+		in, out := &in.CredentialsRotation, &out.CredentialsRotation
+		*out = new(CredentialsRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -540,6 +717,11 @@ func (in *K8SClientSettings) DeepCopy() *K8SClientSettings {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LabelSelector) DeepCopyInto(out *LabelSelector) {
 	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -720,6 +902,21 @@ func (in *LandscaperDeploymentSpec) DeepCopyInto(out *LandscaperDeploymentSpec)
 		*out = new(DataPlane)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(Hibernation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchedulingConstraints != nil {
+		in, out := &in.SchedulingConstraints, &out.SchedulingConstraints
+		*out = new(SchedulingConstraints)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -746,6 +943,18 @@ func (in *LandscaperDeploymentStatus) DeepCopyInto(out *LandscaperDeploymentStat
 		*out = new(ObjectReference)
 		**out = **in
 	}
+	if in.SchedulingDecision != nil {
+		in, out := &in.SchedulingDecision, &out.SchedulingDecision
+		*out = new(SchedulingDecision)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -762,6 +971,10 @@ func (in *LandscaperDeploymentStatus) DeepCopy() *LandscaperDeploymentStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LandscaperServiceComponent) DeepCopyInto(out *LandscaperServiceComponent) {
 	*out = *in
+	if in.DeprecationDate != nil {
+		in, out := &in.DeprecationDate, &out.DeprecationDate
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -775,6 +988,43 @@ func (in *LandscaperServiceComponent) DeepCopy() *LandscaperServiceComponent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchedSchedulingRule) DeepCopyInto(out *MatchedSchedulingRule) {
+	*out = *in
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchedSchedulingRule.
+func (in *MatchedSchedulingRule) DeepCopy() *MatchedSchedulingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchedSchedulingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceRegistration) DeepCopyInto(out *NamespaceRegistration) {
 	*out = *in
@@ -906,49 +1156,61 @@ func (in *ObjectReference) DeepCopy() *ObjectReference {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRequests) DeepCopyInto(out *ResourceRequests) {
+func (in *RebalanceMove) DeepCopyInto(out *RebalanceMove) {
 	*out = *in
+	out.Instance = in.Instance
+	out.Deployment = in.Deployment
+	out.Source = in.Source
+	out.Destination = in.Destination
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequests.
-func (in *ResourceRequests) DeepCopy() *ResourceRequests {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalanceMove.
+func (in *RebalanceMove) DeepCopy() *RebalanceMove {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRequests)
+	out := new(RebalanceMove)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Resources) DeepCopyInto(out *Resources) {
+func (in *RebalancePlan) DeepCopyInto(out *RebalancePlan) {
 	*out = *in
-	out.Requests = in.Requests
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
-func (in *Resources) DeepCopy() *Resources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalancePlan.
+func (in *RebalancePlan) DeepCopy() *RebalancePlan {
 	if in == nil {
 		return nil
 	}
-	out := new(Resources)
+	out := new(RebalancePlan)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RebalancePlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SchedulingRule) DeepCopyInto(out *SchedulingRule) {
+func (in *RebalancePlanList) DeepCopyInto(out *RebalancePlanList) {
 	*out = *in
-	if in.ServiceTargetConfigs != nil {
-		in, out := &in.ServiceTargetConfigs, &out.ServiceTargetConfigs
-		*out = make([]ObjectReference, len(*in))
-		copy(*out, *in)
-	}
-	if in.Selector != nil {
-		in, out := &in.Selector, &out.Selector
-		*out = make([]Selector, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RebalancePlan, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -956,85 +1218,316 @@ func (in *SchedulingRule) DeepCopyInto(out *SchedulingRule) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingRule.
-func (in *SchedulingRule) DeepCopy() *SchedulingRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalancePlanList.
+func (in *RebalancePlanList) DeepCopy() *RebalancePlanList {
 	if in == nil {
 		return nil
 	}
-	out := new(SchedulingRule)
+	out := new(RebalancePlanList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RebalancePlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+func (in *RebalancePlanSpec) DeepCopyInto(out *RebalancePlanSpec) {
 	*out = *in
-	out.ObjectReference = in.ObjectReference
+	if in.ServiceTargetConfigs != nil {
+		in, out := &in.ServiceTargetConfigs, &out.ServiceTargetConfigs
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxMoves != nil {
+		in, out := &in.MaxMoves, &out.MaxMoves
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
-func (in *SecretReference) DeepCopy() *SecretReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalancePlanSpec.
+func (in *RebalancePlanSpec) DeepCopy() *RebalancePlanSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretReference)
+	out := new(RebalancePlanSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Selector) DeepCopyInto(out *Selector) {
+func (in *RebalancePlanStatus) DeepCopyInto(out *RebalancePlanStatus) {
 	*out = *in
-	if in.MatchTenant != nil {
-		in, out := &in.MatchTenant, &out.MatchTenant
-		*out = new(TenantSelector)
-		**out = **in
-	}
-	if in.MatchLabel != nil {
-		in, out := &in.MatchLabel, &out.MatchLabel
-		*out = new(LabelSelector)
-		**out = **in
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Or != nil {
-		in, out := &in.Or, &out.Or
-		*out = make([]Selector, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.PlanTime != nil {
+		in, out := &in.PlanTime, &out.PlanTime
+		*out = (*in).DeepCopy()
 	}
-	if in.And != nil {
-		in, out := &in.And, &out.And
-		*out = make([]Selector, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
-	if in.Not != nil {
-		in, out := &in.Not, &out.Not
-		*out = new(Selector)
-		(*in).DeepCopyInto(*out)
+	if in.Moves != nil {
+		in, out := &in.Moves, &out.Moves
+		*out = make([]RebalanceMove, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Selector.
-func (in *Selector) DeepCopy() *Selector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebalancePlanStatus.
+func (in *RebalancePlanStatus) DeepCopy() *RebalancePlanStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Selector)
+	out := new(RebalancePlanStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceTargetConfig) DeepCopyInto(out *ServiceTargetConfig) {
+func (in *ResourceRequests) DeepCopyInto(out *ResourceRequests) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequests.
+func (in *ResourceRequests) DeepCopy() *ResourceRequests {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRequests)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Resources) DeepCopyInto(out *Resources) {
+	*out = *in
+	out.Requests = in.Requests
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
+func (in *Resources) DeepCopy() *Resources {
+	if in == nil {
+		return nil
+	}
+	out := new(Resources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingCandidate) DeepCopyInto(out *SchedulingCandidate) {
+	*out = *in
+	out.ServiceTargetConfig = in.ServiceTargetConfig
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingCandidate.
+func (in *SchedulingCandidate) DeepCopy() *SchedulingCandidate {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingCandidate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingConstraints) DeepCopyInto(out *SchedulingConstraints) {
+	*out = *in
+	if in.SpreadConstraints != nil {
+		in, out := &in.SpreadConstraints, &out.SpreadConstraints
+		*out = make([]SpreadConstraint, len(*in))
+		copy(*out, *in)
+	}
+	if in.AntiAffinity != nil {
+		in, out := &in.AntiAffinity, &out.AntiAffinity
+		*out = make([]AntiAffinityTerm, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingConstraints.
+func (in *SchedulingConstraints) DeepCopy() *SchedulingConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingDecision) DeepCopyInto(out *SchedulingDecision) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.MatchedRules != nil {
+		in, out := &in.MatchedRules, &out.MatchedRules
+		*out = make([]MatchedSchedulingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Candidates != nil {
+		in, out := &in.Candidates, &out.Candidates
+		*out = make([]SchedulingCandidate, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selected != nil {
+		in, out := &in.Selected, &out.Selected
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingDecision.
+func (in *SchedulingDecision) DeepCopy() *SchedulingDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingRule) DeepCopyInto(out *SchedulingRule) {
+	*out = *in
+	if in.ServiceTargetConfigs != nil {
+		in, out := &in.ServiceTargetConfigs, &out.ServiceTargetConfigs
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make([]Selector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = new(SchedulingConstraints)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingRule.
+func (in *SchedulingRule) DeepCopy() *SchedulingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+	*out = *in
+	out.ObjectReference = in.ObjectReference
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Selector) DeepCopyInto(out *Selector) {
+	*out = *in
+	if in.MatchTenant != nil {
+		in, out := &in.MatchTenant, &out.MatchTenant
+		*out = new(TenantSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MatchLabel != nil {
+		in, out := &in.MatchLabel, &out.MatchLabel
+		*out = new(LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MatchPurpose != nil {
+		in, out := &in.MatchPurpose, &out.MatchPurpose
+		*out = new(StringSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MatchNamespace != nil {
+		in, out := &in.MatchNamespace, &out.MatchNamespace
+		*out = new(StringSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MatchHighAvailability != nil {
+		in, out := &in.MatchHighAvailability, &out.MatchHighAvailability
+		*out = new(HighAvailabilitySelector)
+		**out = **in
+	}
+	if in.MatchDataPlane != nil {
+		in, out := &in.MatchDataPlane, &out.MatchDataPlane
+		*out = new(DataPlaneSelector)
+		**out = **in
+	}
+	if in.Or != nil {
+		in, out := &in.Or, &out.Or
+		*out = make([]Selector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.And != nil {
+		in, out := &in.And, &out.And
+		*out = make([]Selector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Not != nil {
+		in, out := &in.Not, &out.Not
+		*out = new(Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Selector.
+func (in *Selector) DeepCopy() *Selector {
+	if in == nil {
+		return nil
+	}
+	out := new(Selector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceTargetConfig) DeepCopyInto(out *ServiceTargetConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1056,6 +1549,37 @@ func (in *ServiceTargetConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceTargetConfigDrainStatus) DeepCopyInto(out *ServiceTargetConfigDrainStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RemainingInstances != nil {
+		in, out := &in.RemainingInstances, &out.RemainingInstances
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.MigratingInstance != nil {
+		in, out := &in.MigratingInstance, &out.MigratingInstance
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceTargetConfigDrainStatus.
+func (in *ServiceTargetConfigDrainStatus) DeepCopy() *ServiceTargetConfigDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceTargetConfigDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceTargetConfigList) DeepCopyInto(out *ServiceTargetConfigList) {
 	*out = *in
@@ -1093,6 +1617,16 @@ func (in *ServiceTargetConfigList) DeepCopyObject() runtime.Object {
 func (in *ServiceTargetConfigSpec) DeepCopyInto(out *ServiceTargetConfigSpec) {
 	*out = *in
 	out.SecretRef = in.SecretRef
+	if in.MaxInstances != nil {
+		in, out := &in.MaxInstances, &out.MaxInstances
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxInstancesPerTenant != nil {
+		in, out := &in.MaxInstancesPerTenant, &out.MaxInstancesPerTenant
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -1109,11 +1643,37 @@ func (in *ServiceTargetConfigSpec) DeepCopy() *ServiceTargetConfigSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceTargetConfigStatus) DeepCopyInto(out *ServiceTargetConfigStatus) {
 	*out = *in
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(ServiceTargetConfigUsage)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.InstanceRefs != nil {
 		in, out := &in.InstanceRefs, &out.InstanceRefs
 		*out = make([]ObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(ServiceTargetConfigDrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1127,6 +1687,91 @@ func (in *ServiceTargetConfigStatus) DeepCopy() *ServiceTargetConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceTargetConfigUsage) DeepCopyInto(out *ServiceTargetConfigUsage) {
+	*out = *in
+	if in.InstancesByPhase != nil {
+		in, out := &in.InstancesByPhase, &out.InstancesByPhase
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequestedCPU != nil {
+		in, out := &in.RequestedCPU, &out.RequestedCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.RequestedMemory != nil {
+		in, out := &in.RequestedMemory, &out.RequestedMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.NodeCount != nil {
+		in, out := &in.NodeCount, &out.NodeCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.AllocatableCPU != nil {
+		in, out := &in.AllocatableCPU, &out.AllocatableCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.AllocatableMemory != nil {
+		in, out := &in.AllocatableMemory, &out.AllocatableMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceTargetConfigUsage.
+func (in *ServiceTargetConfigUsage) DeepCopy() *ServiceTargetConfigUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceTargetConfigUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpreadConstraint) DeepCopyInto(out *SpreadConstraint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpreadConstraint.
+func (in *SpreadConstraint) DeepCopy() *SpreadConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(SpreadConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StringSelector) DeepCopyInto(out *StringSelector) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StringSelector.
+func (in *StringSelector) DeepCopy() *StringSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(StringSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subject) DeepCopyInto(out *Subject) {
 	*out = *in
@@ -1252,6 +1897,7 @@ func (in *TargetScheduling) DeepCopyInto(out *TargetScheduling) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -1329,9 +1975,176 @@ func (in *TargetSchedulingSpec) DeepCopy() *TargetSchedulingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSchedulingStatus) DeepCopyInto(out *TargetSchedulingStatus) {
+	*out = *in
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSchedulingStatus.
+func (in *TargetSchedulingStatus) DeepCopy() *TargetSchedulingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSchedulingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantQuota) DeepCopyInto(out *TenantQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantQuota.
+func (in *TenantQuota) DeepCopy() *TenantQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantQuotaList) DeepCopyInto(out *TenantQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TenantQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantQuotaList.
+func (in *TenantQuotaList) DeepCopy() *TenantQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantQuotaResources) DeepCopyInto(out *TenantQuotaResources) {
+	*out = *in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = new(int)
+		**out = **in
+	}
+	if in.HighAvailabilityInstances != nil {
+		in, out := &in.HighAvailabilityInstances, &out.HighAvailabilityInstances
+		*out = new(int)
+		**out = **in
+	}
+	if in.RequestedCPU != nil {
+		in, out := &in.RequestedCPU, &out.RequestedCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.RequestedMemory != nil {
+		in, out := &in.RequestedMemory, &out.RequestedMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantQuotaResources.
+func (in *TenantQuotaResources) DeepCopy() *TenantQuotaResources {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantQuotaResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantQuotaSpec) DeepCopyInto(out *TenantQuotaSpec) {
+	*out = *in
+	in.Limits.DeepCopyInto(&out.Limits)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantQuotaSpec.
+func (in *TenantQuotaSpec) DeepCopy() *TenantQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantQuotaStatus) DeepCopyInto(out *TenantQuotaStatus) {
+	*out = *in
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Used.DeepCopyInto(&out.Used)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantQuotaStatus.
+func (in *TenantQuotaStatus) DeepCopy() *TenantQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TenantSelector) DeepCopyInto(out *TenantSelector) {
 	*out = *in
+	if in.IDs != nil {
+		in, out := &in.IDs, &out.IDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
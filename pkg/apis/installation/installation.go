@@ -5,6 +5,8 @@
 package installation
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -156,6 +158,8 @@ type LandscaperConfig struct {
 	Deployers []string `json:"deployers"`
 	// DeployersConfig specifies the configuration for the landscaper standard deployers.
 	DeployersConfig map[string]*lssv1alpha1.DeployerConfig `json:"deployersConfig,omitempty"`
+	// Hibernated scales the landscaper controller and webhooks server deployments to zero replicas.
+	Hibernated bool `json:"hibernated,omitempty"`
 }
 
 // NewLandscaperConfig creates a new landscaper configuration initialized with default values.
@@ -183,6 +187,18 @@ func (l *LandscaperConfig) ToAnyJSON() (*lsv1alpha1.AnyJSON, error) {
 type SidecarConfig struct {
 	// Verbosity defines the logging verbosity level.
 	Verbosity string `json:"verbosity,omitempty"`
+	// Hibernated scales the sidecar controller deployment to zero replicas.
+	Hibernated bool `json:"hibernated,omitempty"`
+	// WebhooksServer enables the validation webhooks of the sidecar server.
+	WebhooksServer *SidecarWebhooksServer `json:"webhooksServer,omitempty"`
+}
+
+// SidecarWebhooksServer specifies the sidecar webhooks server configuration.
+type SidecarWebhooksServer struct {
+	// URL is the url under which the api server of the resource cluster can reach the webhooks server.
+	URL string `json:"url"`
+	// CertificatesNamespace is the namespace in which the webhook certificates are stored.
+	CertificatesNamespace string `json:"certificatesNamespace"`
 }
 
 // NewSidecarConfig creates a new SidecarConfig.
@@ -206,6 +222,12 @@ type RotationConfig struct {
 	// AdminKubeconfigExpirationSeconds defines how long the admin kubeconfig for a resource cluster is valid.
 	// The kubeconfig is used to deploy RBAC objects on the resource cluster.
 	AdminKubeconfigExpirationSeconds int64 `json:"adminKubeconfigExpirationSeconds,omitempty"`
+	// RotationTimestamp is the point in time at which the last rotation of the credentials has been requested.
+	// A changed value forces the regeneration of the tokens and kubeconfigs.
+	RotationTimestamp string `json:"rotationTimestamp,omitempty"`
+	// ServiceAccountSuffix is appended to the names of the service accounts on the resource cluster.
+	// It changes with every rotation, so that the service accounts are recreated and the tokens issued before are invalidated.
+	ServiceAccountSuffix string `json:"serviceAccountSuffix,omitempty"`
 }
 
 // NewRotationConfig creates a new RotationConfig.
@@ -216,6 +238,18 @@ func NewRotationConfig(tokenExpirationSeconds, adminKubeconfigExpirationSeconds
 	}
 }
 
+// WithRotationTimestamp sets the point in time at which the last rotation of the credentials has been requested.
+// The service account suffix is derived from the rotation timestamp.
+func (r *RotationConfig) WithRotationTimestamp(rotationTimestamp string) *RotationConfig {
+	r.RotationTimestamp = rotationTimestamp
+	r.ServiceAccountSuffix = ""
+	if len(rotationTimestamp) > 0 {
+		hash := sha256.Sum256([]byte(rotationTimestamp))
+		r.ServiceAccountSuffix = "-" + hex.EncodeToString(hash[:])[:8]
+	}
+	return r
+}
+
 // ToAnyJSON marshals this RotationConfig to an AnyJSON object.
 func (r *RotationConfig) ToAnyJSON() (*lsv1alpha1.AnyJSON, error) {
 	return toAnyJSON(r)
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// ValidateHibernation validates the hibernation of an instance
+func ValidateHibernation(hibernation *v1alpha1.Hibernation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, schedule := range hibernation.Schedules {
+		schedulePath := fldPath.Child("schedules").Index(i)
+
+		if _, err := utils.ParseCronSchedule(schedule.Start); err != nil {
+			allErrs = append(allErrs, field.Invalid(schedulePath.Child("start"), schedule.Start, err.Error()))
+		}
+		if _, err := utils.ParseCronSchedule(schedule.End); err != nil {
+			allErrs = append(allErrs, field.Invalid(schedulePath.Child("end"), schedule.End, err.Error()))
+		}
+		if len(schedule.Location) > 0 {
+			if _, err := time.LoadLocation(schedule.Location); err != nil {
+				allErrs = append(allErrs, field.Invalid(schedulePath.Child("location"), schedule.Location, err.Error()))
+			}
+		}
+	}
+
+	return allErrs
+}
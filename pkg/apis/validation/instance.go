@@ -25,7 +25,9 @@ func ValidateInstance(instance *v1alpha1.Instance, oldInstance *v1alpha1.Instanc
 	allErrs = append(allErrs, validateInstanceObjectMeta(&instance.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateInstanceSpec(&instance.Spec, field.NewPath("spec"))...)
 	if oldInstance != nil {
-		allErrs = append(allErrs, validateInstanceSpecUpdate(&instance.Spec, &oldInstance.Spec, field.NewPath("spec"))...)
+		migrate := instance.Annotations[v1alpha1.LandscaperServiceOperationAnnotation] == v1alpha1.LandscaperServiceOperationMigrate
+		allErrs = append(allErrs, validateInstanceSpecUpdate(&instance.Spec, &oldInstance.Spec, migrate, field.NewPath("spec"))...)
+		allErrs = append(allErrs, validateInstanceMigration(instance, oldInstance, migrate, field.NewPath("spec"))...)
 	}
 	return allErrs
 }
@@ -52,6 +54,10 @@ func validateInstanceSpec(spec *v1alpha1.InstanceSpec, fldPath *field.Path) fiel
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dataPlane"), "dataPlane can't be used in combination with oidcConfig or highAvailabilityConfig"))
 	}
 
+	if spec.DataPlane != nil && len(spec.ShootProfile) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("shootProfile"), "shootProfile can't be used in combination with dataPlane"))
+	}
+
 	if spec.HighAvailabilityConfig != nil {
 		allErrs = append(allErrs, ValidateHighAvailabilityConfig(spec.HighAvailabilityConfig, fldPath.Child("highAvailabilityConfig"))...)
 	}
@@ -60,10 +66,63 @@ func validateInstanceSpec(spec *v1alpha1.InstanceSpec, fldPath *field.Path) fiel
 		allErrs = append(allErrs, ValidateDataPlane(spec.DataPlane, fldPath.Child("dataPlane"))...)
 	}
 
+	if spec.MaintenanceWindow != nil {
+		allErrs = append(allErrs, ValidateMaintenanceWindow(spec.MaintenanceWindow, fldPath.Child("maintenanceWindow"))...)
+	}
+
+	allErrs = append(allErrs, ValidateUserKubeconfigSecretName(spec.UserKubeconfigSecretName, spec.DataPlane, fldPath.Child("userKubeconfigSecretName"))...)
+
+	if spec.Hibernation != nil {
+		allErrs = append(allErrs, ValidateHibernation(spec.Hibernation, fldPath.Child("hibernation"))...)
+	}
+
 	return allErrs
 }
 
-func validateInstanceSpecUpdate(spec *v1alpha1.InstanceSpec, oldSpec *v1alpha1.InstanceSpec, fldPath *field.Path) field.ErrorList {
+// validateInstanceMigration validates that a migration is only started for an instance whose service target config
+// has been recorded in its status. Otherwise, the source of the migration is unknown and the resources on the
+// source target cluster would not be removed.
+// The destination of a running migration can't be changed, because the resources which have already been created
+// on the target cluster of the previous destination would not be removed.
+func validateInstanceMigration(instance *v1alpha1.Instance, oldInstance *v1alpha1.Instance, migrate bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !migrate || instance.Spec.ServiceTargetConfigRef.Equals(&oldInstance.Spec.ServiceTargetConfigRef) {
+		return allErrs
+	}
+
+	if oldInstance.Status.ServiceTargetConfigRef == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("serviceTargetConfigRef"),
+			"can't be migrated before the instance has been reconciled and status.serviceTargetConfigRef has been set"))
+	} else if oldInstance.IsMigrating() {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("serviceTargetConfigRef"),
+			fmt.Sprintf("can't be changed while the instance is being migrated to service target config %s",
+				oldInstance.Spec.ServiceTargetConfigRef.NamespacedName().String())))
+	}
+
+	return allErrs
+}
+
+// ValidateInstanceMigrationDestination validates that the destination service target config of a migration
+// is available for the instance. The destination must not be cordoned and must be located in the region of the instance.
+func ValidateInstanceMigrationDestination(instance *v1alpha1.Instance, destination *v1alpha1.ServiceTargetConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if destination.Spec.Cordoned {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "destination service target config is cordoned"))
+	}
+
+	if len(instance.Spec.Region) > 0 && destination.Spec.Region != instance.Spec.Region {
+		allErrs = append(allErrs, field.Invalid(fldPath, instance.Spec.ServiceTargetConfigRef.NamespacedName().String(),
+			fmt.Sprintf("destination service target config is located in region %q instead of region %q", destination.Spec.Region, instance.Spec.Region)))
+	}
+
+	return allErrs
+}
+
+// validateInstanceSpecUpdate validates the update of an instance spec.
+// The service target config reference can only be changed if a migration is requested.
+func validateInstanceSpecUpdate(spec *v1alpha1.InstanceSpec, oldSpec *v1alpha1.InstanceSpec, migrate bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if spec.TenantId != oldSpec.TenantId {
@@ -74,8 +133,13 @@ func validateInstanceSpecUpdate(spec *v1alpha1.InstanceSpec, oldSpec *v1alpha1.I
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("id"), "is immutable"))
 	}
 
-	if !spec.ServiceTargetConfigRef.Equals(&oldSpec.ServiceTargetConfigRef) {
-		allErrs = append(allErrs, field.Forbidden(fldPath.Child("serviceTargetConfigRef"), "is immutable"))
+	if spec.Region != oldSpec.Region {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("region"), "is immutable"))
+	}
+
+	if !migrate && !spec.ServiceTargetConfigRef.Equals(&oldSpec.ServiceTargetConfigRef) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("serviceTargetConfigRef"),
+			fmt.Sprintf("is immutable unless annotation %s=%s is set", v1alpha1.LandscaperServiceOperationAnnotation, v1alpha1.LandscaperServiceOperationMigrate)))
 	}
 
 	if spec.HighAvailabilityConfig != nil && oldSpec.HighAvailabilityConfig != nil {
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// ValidateUserKubeconfigSecretName validates the name of the secret into which the user kubeconfig is written.
+// The user kubeconfig is only exported for an internal data plane.
+func ValidateUserKubeconfigSecretName(name string, dataPlane *v1alpha1.DataPlane, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(name) == 0 {
+		return allErrs
+	}
+
+	for _, msg := range apivalidation.NameIsDNSSubdomain(name, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, msg))
+	}
+
+	if dataPlane != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "userKubeconfigSecretName can't be used in combination with dataPlane"))
+	}
+
+	return allErrs
+}
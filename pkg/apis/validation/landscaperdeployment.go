@@ -51,6 +51,10 @@ func validateLandscaperDeploymentSpec(spec *v1alpha1.LandscaperDeploymentSpec, f
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dataPlane"), "dataPlane can't be used in combination with oidcConfig or highAvailabilityConfig"))
 	}
 
+	if spec.DataPlane != nil && len(spec.ShootProfile) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("shootProfile"), "shootProfile can't be used in combination with dataPlane"))
+	}
+
 	if spec.HighAvailabilityConfig != nil {
 		allErrs = append(allErrs, ValidateHighAvailabilityConfig(spec.HighAvailabilityConfig, fldPath.Child("highAvailabilityConfig"))...)
 	}
@@ -59,6 +63,20 @@ func validateLandscaperDeploymentSpec(spec *v1alpha1.LandscaperDeploymentSpec, f
 		allErrs = append(allErrs, ValidateDataPlane(spec.DataPlane, fldPath.Child("dataPlane"))...)
 	}
 
+	if spec.MaintenanceWindow != nil {
+		allErrs = append(allErrs, ValidateMaintenanceWindow(spec.MaintenanceWindow, fldPath.Child("maintenanceWindow"))...)
+	}
+
+	allErrs = append(allErrs, ValidateUserKubeconfigSecretName(spec.UserKubeconfigSecretName, spec.DataPlane, fldPath.Child("userKubeconfigSecretName"))...)
+
+	if spec.Hibernation != nil {
+		allErrs = append(allErrs, ValidateHibernation(spec.Hibernation, fldPath.Child("hibernation"))...)
+	}
+
+	if spec.SchedulingConstraints != nil {
+		allErrs = append(allErrs, ValidateSchedulingConstraints(spec.SchedulingConstraints, fldPath.Child("schedulingConstraints"))...)
+	}
+
 	return allErrs
 }
 
@@ -69,6 +87,10 @@ func validateLandscaperDeploymentSpecUpdate(spec *v1alpha1.LandscaperDeploymentS
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("tenantId"), "is immutable"))
 	}
 
+	if spec.Region != oldSpec.Region {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("region"), "is immutable"))
+	}
+
 	if spec.HighAvailabilityConfig != nil && oldSpec.HighAvailabilityConfig != nil {
 		if spec.HighAvailabilityConfig.ControlPlaneFailureTolerance != oldSpec.HighAvailabilityConfig.ControlPlaneFailureTolerance {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("highAvailabilityConfig").Child("controlPlaneFailureTolerance"), "is immutable"))
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// ValidateMaintenanceWindow validates a maintenance window
+func ValidateMaintenanceWindow(window *v1alpha1.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	begin, err := utils.ParseMaintenanceWindowTime(window.Begin)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("begin"), window.Begin, err.Error()))
+	}
+
+	end, err := utils.ParseMaintenanceWindowTime(window.End)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("end"), window.End, err.Error()))
+	}
+
+	if len(allErrs) == 0 && begin == end {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("end"), window.End, "must differ from begin"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// ValidateNamespaceRegistration validates a NamespaceRegistration
+func ValidateNamespaceRegistration(namespaceRegistration *v1alpha1.NamespaceRegistration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("metadata", "name")
+	name := namespaceRegistration.GetName()
+
+	if !strings.HasPrefix(name, v1alpha1.CustomNamespacePrefix) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, fmt.Sprintf("name must start with %q", v1alpha1.CustomNamespacePrefix)))
+	}
+
+	for _, msg := range apivalidation.ValidateNamespaceName(name, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, msg))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// ValidateRebalancePlan validates a RebalancePlan
+func ValidateRebalancePlan(plan *v1alpha1.RebalancePlan) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("spec")
+
+	configsPath := fldPath.Child("serviceTargetConfigs")
+	for i := range plan.Spec.ServiceTargetConfigs {
+		allErrs = append(allErrs, ValidateObjectReference(&plan.Spec.ServiceTargetConfigs[i], configsPath.Index(i))...)
+	}
+
+	if plan.Spec.MaxMoves != nil && *plan.Spec.MaxMoves < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxMoves"), *plan.Spec.MaxMoves, "must be an integer >= 0"))
+	}
+
+	return allErrs
+}
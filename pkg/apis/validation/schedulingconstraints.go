@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// ValidateSchedulingConstraints validates the scheduling constraints of a scheduling rule or a landscaper deployment
+func ValidateSchedulingConstraints(constraints *v1alpha1.SchedulingConstraints, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, constraint := range constraints.SpreadConstraints {
+		constraintPath := fldPath.Child("spreadConstraints").Index(i)
+
+		if len(constraint.TopologyKey) > 0 {
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(constraint.TopologyKey, constraintPath.Child("topologyKey"))...)
+		}
+		if constraint.MaxSkew < 0 {
+			allErrs = append(allErrs, field.Invalid(constraintPath.Child("maxSkew"), constraint.MaxSkew, "maxSkew must be an integer >= 0"))
+		}
+		allErrs = append(allErrs, validateUnsatisfiableConstraintAction(constraint.WhenUnsatisfiable, constraintPath.Child("whenUnsatisfiable"))...)
+	}
+
+	for i, term := range constraints.AntiAffinity {
+		termPath := fldPath.Child("antiAffinity").Index(i)
+
+		if len(term.LabelKey) == 0 {
+			allErrs = append(allErrs, field.Required(termPath.Child("labelKey"), "label key needs to be set"))
+		} else {
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(term.LabelKey, termPath.Child("labelKey"))...)
+		}
+		if len(term.TopologyKey) > 0 {
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(term.TopologyKey, termPath.Child("topologyKey"))...)
+		}
+		allErrs = append(allErrs, validateUnsatisfiableConstraintAction(term.WhenUnsatisfiable, termPath.Child("whenUnsatisfiable"))...)
+	}
+
+	return allErrs
+}
+
+func validateUnsatisfiableConstraintAction(action v1alpha1.UnsatisfiableConstraintAction, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch action {
+	case "", v1alpha1.DoNotSchedule, v1alpha1.ScheduleAnyway:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, action, []string{
+			string(v1alpha1.DoNotSchedule),
+			string(v1alpha1.ScheduleAnyway),
+		}))
+	}
+
+	return allErrs
+}
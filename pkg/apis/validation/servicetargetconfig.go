@@ -46,5 +46,13 @@ func validateServiceTargetConfigSpec(spec *v1alpha1.ServiceTargetConfigSpec, fld
 		allErrs = append(allErrs, field.Required(fldPath.Child("ingressDomain"), "ingressDomain may not be empty"))
 	}
 
+	if spec.MaxInstances != nil && *spec.MaxInstances < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxInstances"), *spec.MaxInstances, "maxInstances must not be negative"))
+	}
+
+	if spec.MaxInstancesPerTenant != nil && *spec.MaxInstancesPerTenant < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxInstancesPerTenant"), *spec.MaxInstancesPerTenant, "maxInstancesPerTenant must not be negative"))
+	}
+
 	return allErrs
 }
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+)
+
+// ValidateShootProfile validates the selected shoot profile against the shoot profiles of the landscaper service.
+// An empty shoot profile selects the default shoot configuration.
+// An unchanged shoot profile is valid, even if it has been removed from the landscaper service in the meantime.
+// A change of the shoot profile is validated against the installed kubernetes version by ValidateShootProfileKubernetesVersion.
+func ValidateShootProfile(shootProfile, oldShootProfile string, shootProfiles []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(shootProfile) == 0 || shootProfile == oldShootProfile {
+		return allErrs
+	}
+
+	if len(shootProfiles) == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "the landscaper service does not support the selection of a shoot profile"))
+		return allErrs
+	}
+
+	if !sets.New[string](shootProfiles...).Has(shootProfile) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, shootProfile, shootProfiles))
+	}
+
+	return allErrs
+}
+
+// ValidateShootProfileKubernetesVersion validates the kubernetes version of a newly selected shoot profile
+// against the kubernetes version of the installed shoot cluster, because gardener rejects the downgrade of a shoot cluster.
+// The validation is skipped if one of the versions is empty or invalid.
+func ValidateShootProfileKubernetesVersion(kubernetesVersion, installedVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(kubernetesVersion) == 0 || len(installedVersion) == 0 {
+		return allErrs
+	}
+
+	version, err := utilversion.ParseGeneric(kubernetesVersion)
+	if err != nil {
+		return allErrs
+	}
+	installed, err := utilversion.ParseGeneric(installedVersion)
+	if err != nil {
+		return allErrs
+	}
+
+	if version.LessThan(installed) {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			fmt.Sprintf("selects kubernetes version %s, which is lower than the installed kubernetes version %s", kubernetesVersion, installedVersion)))
+	}
+
+	return allErrs
+}
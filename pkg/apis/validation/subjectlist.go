@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// supportedSubjectKinds are the kinds of subjects, which can be used in a SubjectList
+var supportedSubjectKinds = []string{
+	v1alpha1.SubjectListEntryUser,
+	v1alpha1.SubjectListEntryGroup,
+	v1alpha1.SubjectListEntryServiceAccount,
+}
+
+// ValidateSubjectList validates a SubjectList
+func ValidateSubjectList(subjectList *v1alpha1.SubjectList) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("spec")
+
+	allErrs = append(allErrs, validateSubjects(subjectList.Spec.Subjects, fldPath.Child("subjects"))...)
+	allErrs = append(allErrs, validateSubjects(subjectList.Spec.ViewerSubjects, fldPath.Child("viewerSubjects"))...)
+
+	return allErrs
+}
+
+// validateSubjects validates the subjects of a SubjectList
+func validateSubjects(subjects []v1alpha1.Subject, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, subject := range subjects {
+		subjectPath := fldPath.Index(i)
+
+		if len(subject.Name) == 0 {
+			allErrs = append(allErrs, field.Required(subjectPath.Child("name"), "name may not be empty"))
+		}
+
+		switch subject.Kind {
+		case v1alpha1.SubjectListEntryUser, v1alpha1.SubjectListEntryGroup:
+			// the namespace of users and groups is ignored
+		case v1alpha1.SubjectListEntryServiceAccount:
+			if len(subject.Name) > 0 {
+				for _, msg := range apivalidation.ValidateServiceAccountName(subject.Name, false) {
+					allErrs = append(allErrs, field.Invalid(subjectPath.Child("name"), subject.Name, msg))
+				}
+			}
+			if len(subject.Namespace) > 0 {
+				for _, msg := range apivalidation.ValidateNamespaceName(subject.Namespace, false) {
+					allErrs = append(allErrs, field.Invalid(subjectPath.Child("namespace"), subject.Namespace, msg))
+				}
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(subjectPath.Child("kind"), subject.Kind, supportedSubjectKinds))
+		}
+	}
+
+	return allErrs
+}
@@ -5,6 +5,8 @@
 package validation
 
 import (
+	"regexp"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
@@ -51,6 +53,10 @@ func validateSchedulingRule(rule *v1alpha1.SchedulingRule, fldPath *field.Path)
 		allErrs = append(allErrs, validateSchedulingSelector(&rule.Selector[i], selectorPath.Index(i))...)
 	}
 
+	if rule.Constraints != nil {
+		allErrs = append(allErrs, ValidateSchedulingConstraints(rule.Constraints, fldPath.Child("constraints"))...)
+	}
+
 	return allErrs
 }
 
@@ -69,6 +75,18 @@ func validateSchedulingSelector(selector *v1alpha1.Selector, fldPath *field.Path
 	if selector.MatchLabel != nil {
 		count++
 	}
+	if selector.MatchPurpose != nil {
+		count++
+	}
+	if selector.MatchNamespace != nil {
+		count++
+	}
+	if selector.MatchHighAvailability != nil {
+		count++
+	}
+	if selector.MatchDataPlane != nil {
+		count++
+	}
 	if selector.Or != nil {
 		count++
 	}
@@ -85,16 +103,34 @@ func validateSchedulingSelector(selector *v1alpha1.Selector, fldPath *field.Path
 
 	// check term
 	if selector.MatchTenant != nil {
-		if len(selector.MatchTenant.ID) == 0 {
-			allErrs = append(allErrs, field.Required(fldPath.Child("matchTenant").Child("id"), "tenant id needs to be set"))
-		}
+		allErrs = append(allErrs, validateTenantSelector(selector.MatchTenant, fldPath.Child("matchTenant"))...)
 
 	} else if selector.MatchLabel != nil {
-		if len(selector.MatchLabel.Name) == 0 {
-			allErrs = append(allErrs, field.Required(fldPath.Child("matchLabel").Child("name"), "label name needs to be set"))
+		allErrs = append(allErrs, validateLabelSelector(selector.MatchLabel, fldPath.Child("matchLabel"))...)
+
+	} else if selector.MatchPurpose != nil {
+		allErrs = append(allErrs, validateStringSelector(selector.MatchPurpose, fldPath.Child("matchPurpose"))...)
+
+	} else if selector.MatchNamespace != nil {
+		allErrs = append(allErrs, validateStringSelector(selector.MatchNamespace, fldPath.Child("matchNamespace"))...)
+
+	} else if selector.MatchHighAvailability != nil {
+		haPath := fldPath.Child("matchHighAvailability")
+		tolerance := selector.MatchHighAvailability.ControlPlaneFailureTolerance
+		if len(tolerance) > 0 {
+			if !selector.MatchHighAvailability.Enabled {
+				allErrs = append(allErrs, field.Invalid(haPath.Child("controlPlaneFailureTolerance"), tolerance, "control plane failure tolerance can only be matched if enabled is true"))
+			}
+			if tolerance != "zone" && tolerance != "node" {
+				allErrs = append(allErrs, field.NotSupported(haPath.Child("controlPlaneFailureTolerance"), tolerance, []string{"zone", "node"}))
+			}
 		}
-		if len(selector.MatchLabel.Value) == 0 {
-			allErrs = append(allErrs, field.Required(fldPath.Child("matchLabel").Child("value"), "label value needs to be set"))
+
+	} else if selector.MatchDataPlane != nil {
+		dataPlaneType := selector.MatchDataPlane.Type
+		if dataPlaneType != v1alpha1.LandscaperDeploymentDataPlaneTypeInternal && dataPlaneType != v1alpha1.LandscaperDeploymentDataPlaneTypeExternal {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("matchDataPlane").Child("type"), dataPlaneType,
+				[]string{v1alpha1.LandscaperDeploymentDataPlaneTypeInternal, v1alpha1.LandscaperDeploymentDataPlaneTypeExternal}))
 		}
 
 	} else if selector.And != nil {
@@ -113,3 +149,94 @@ func validateSchedulingSelector(selector *v1alpha1.Selector, fldPath *field.Path
 
 	return allErrs
 }
+
+func validateTenantSelector(selector *v1alpha1.TenantSelector, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	count := 0
+	if len(selector.ID) > 0 {
+		count++
+	}
+	if len(selector.IDs) > 0 {
+		count++
+	}
+	if len(selector.Prefix) > 0 {
+		count++
+	}
+	if len(selector.Regex) > 0 {
+		count++
+		if _, err := regexp.Compile(selector.Regex); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("regex"), selector.Regex, err.Error()))
+		}
+	}
+
+	if count == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("id"), "tenant id needs to be set"))
+	} else if count > 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, selector, "tenant selector must contain exactly one of id, ids, prefix and regex"))
+	}
+
+	return allErrs
+}
+
+func validateLabelSelector(selector *v1alpha1.LabelSelector, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(selector.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "label name needs to be set"))
+	}
+
+	switch selector.Operator {
+	case "":
+		if len(selector.Value) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("value"), "label value needs to be set"))
+		}
+		if len(selector.Values) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("values"), "label values can only be set for the operators In and NotIn"))
+		}
+	case v1alpha1.LabelSelectorOpIn, v1alpha1.LabelSelectorOpNotIn:
+		if len(selector.Values) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("values"), "label values need to be set"))
+		}
+		if len(selector.Value) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("value"), "label value can only be set without operator"))
+		}
+	case v1alpha1.LabelSelectorOpExists, v1alpha1.LabelSelectorOpDoesNotExist:
+		if len(selector.Value) > 0 || len(selector.Values) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "label value and values must not be set for the operators Exists and DoesNotExist"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("operator"), selector.Operator, []string{
+			string(v1alpha1.LabelSelectorOpIn),
+			string(v1alpha1.LabelSelectorOpNotIn),
+			string(v1alpha1.LabelSelectorOpExists),
+			string(v1alpha1.LabelSelectorOpDoesNotExist),
+		}))
+	}
+
+	return allErrs
+}
+
+func validateStringSelector(selector *v1alpha1.StringSelector, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	count := 0
+	if len(selector.Values) > 0 {
+		count++
+	}
+	if len(selector.Prefix) > 0 {
+		count++
+	}
+	if len(selector.Regex) > 0 {
+		count++
+		if _, err := regexp.Compile(selector.Regex); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("regex"), selector.Regex, err.Error()))
+		}
+	}
+
+	if count != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, selector, "selector must contain exactly one of values, prefix and regex"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// ValidateTenantQuota validates a TenantQuota
+func ValidateTenantQuota(quota *v1alpha1.TenantQuota) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("spec")
+
+	if len(quota.Spec.TenantId) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("tenantId"), "tenantId may not be empty"))
+	}
+
+	limitsPath := fldPath.Child("limits")
+	limits := &quota.Spec.Limits
+	if limits.Instances != nil && *limits.Instances < 0 {
+		allErrs = append(allErrs, field.Invalid(limitsPath.Child("instances"), *limits.Instances, "must be an integer >= 0"))
+	}
+	if limits.HighAvailabilityInstances != nil && *limits.HighAvailabilityInstances < 0 {
+		allErrs = append(allErrs, field.Invalid(limitsPath.Child("highAvailabilityInstances"), *limits.HighAvailabilityInstances, "must be an integer >= 0"))
+	}
+	if limits.RequestedCPU != nil && limits.RequestedCPU.Sign() < 0 {
+		allErrs = append(allErrs, field.Invalid(limitsPath.Child("requestedCPU"), limits.RequestedCPU.String(), "must be a quantity >= 0"))
+	}
+	if limits.RequestedMemory != nil && limits.RequestedMemory.Sign() < 0 {
+		allErrs = append(allErrs, field.Invalid(limitsPath.Child("requestedMemory"), limits.RequestedMemory.String(), "must be a quantity >= 0"))
+	}
+
+	return allErrs
+}
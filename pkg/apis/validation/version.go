@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+)
+
+// ValidateVersion validates the selected landscaper service component version against the supported versions.
+// An empty version selects the default version of the landscaper service.
+// When oldVersion is not empty, the version must not be removed or downgraded.
+func ValidateVersion(version, oldVersion string, supportedVersions []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(version) == 0 {
+		if len(oldVersion) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "can not be removed once it has been set"))
+		}
+		return allErrs
+	}
+
+	if version == oldVersion {
+		return allErrs
+	}
+
+	if len(supportedVersions) == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "the landscaper service does not support the selection of a version"))
+		return allErrs
+	}
+
+	if !sets.New[string](supportedVersions...).Has(version) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, version, supportedVersions))
+		return allErrs
+	}
+
+	if len(oldVersion) > 0 {
+		newSemver, err := utilversion.ParseSemantic(version)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, version, fmt.Sprintf("must be a semantic version: %s", err.Error())))
+			return allErrs
+		}
+		oldSemver, err := utilversion.ParseSemantic(oldVersion)
+		if err == nil && newSemver.LessThan(oldSemver) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("can not be downgraded from %s to %s", oldVersion, version)))
+		}
+	}
+
+	return allErrs
+}
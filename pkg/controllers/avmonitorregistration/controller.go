@@ -69,6 +69,12 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			continue
 		}
 
+		//skip hibernated instances, since their landscaper is not running
+		if instance.Status.Hibernated {
+			logger.Debug("skip instance since it is hibernated")
+			continue
+		}
+
 		//get refered installation
 		logger.Debug("fetch referred installation")
 		if instance.Status.InstallationRef == nil || instance.Status.InstallationRef.Name == "" || instance.Status.InstallationRef.Namespace == "" {
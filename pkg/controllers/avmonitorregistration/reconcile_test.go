@@ -93,4 +93,20 @@ var _ = Describe("Reconcile", func() {
 		Expect(testenv.Client.Get(ctx, types.NamespacedName{Namespace: op.Config().AvailabilityMonitoring.AvailabilityCollectionNamespace, Name: op.Config().AvailabilityMonitoring.AvailabilityCollectionName}, availabilitycollection)).To(Succeed())
 		Expect(len(availabilitycollection.Spec.InstanceRefs)).To(Equal(0))
 	})
+
+	It("should not monitor hibernated instances", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+		op.Config().AvailabilityMonitoring.AvailabilityCollectionNamespace = state.Namespace
+
+		instance := state.GetInstance("test")
+		instance.Status.Hibernated = true
+		Expect(testenv.Client.Status().Update(ctx, instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+
+		availabilitycollection := &lssv1alpha1.AvailabilityCollection{}
+		Expect(testenv.Client.Get(ctx, types.NamespacedName{Namespace: op.Config().AvailabilityMonitoring.AvailabilityCollectionNamespace, Name: op.Config().AvailabilityMonitoring.AvailabilityCollectionName}, availabilitycollection)).To(Succeed())
+		Expect(len(availabilitycollection.Spec.InstanceRefs)).To(Equal(0))
+	})
 })
@@ -22,6 +22,7 @@ import (
 
 	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/metrics"
 	"github.com/gardener/landscaper-service/pkg/operation"
 )
 
@@ -77,8 +78,10 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	request := constructAvsRequest(*availabilityCollection)
 
 	logger.Debug("perform avs upload")
+	start := time.Now()
 	err := doAvsRequest(request, c.Config().AvailabilityMonitoring.AvailabilityServiceConfiguration.Url, c.Config().AvailabilityMonitoring.AvailabilityServiceConfiguration.ApiKey,
 		c.Config().AvailabilityMonitoring.AvailabilityServiceConfiguration.Timeout)
+	observeAvsUpload(start, err)
 	if err != nil {
 		logger.Error(err, "avs request failed")
 		return reconcile.Result{}, err
@@ -99,6 +102,16 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 }
 
+// observeAvsUpload records the result and the duration of an avs upload.
+func observeAvsUpload(start time.Time, err error) {
+	result := metrics.ResultSuccess
+	if err != nil {
+		result = metrics.ResultFailure
+	}
+	metrics.AvsUploads.WithLabelValues(result).Inc()
+	metrics.AvsUploadDuration.WithLabelValues(result).Observe(time.Since(start).Seconds())
+}
+
 func constructAvsRequest(availabilityCollection lssv1alpha1.AvailabilityCollection) AvsRequest {
 	//Fill failedInstances with all failed. A failed instance will create an instance outage. If this instance is not in the array anymore, instance outage is resolved
 	// Overall status is derived if len(failedInstances) > 0
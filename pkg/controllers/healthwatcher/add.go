@@ -19,7 +19,7 @@ import (
 // AddControllerToManager adds the HealthWatcher controller to the manager
 func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.LandscaperServiceConfiguration) error {
 	log := logger.Reconciles("HealthWatcher", "AvailabilityCollection")
-	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), config)
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("landscaper-service-healthwatcher"), config)
 	if err != nil {
 		return err
 	}
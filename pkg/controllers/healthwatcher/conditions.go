@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthwatcher
+
+import (
+	"context"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// updateInstanceHealthConditions transfers the availability status of the monitored instances
+// to the Healthy condition of the instances.
+// Failures are only logged, since the availability collection is the primary result of the health check.
+func (c *Controller) updateInstanceHealthConditions(ctx context.Context, availabilityCollection *lssv1alpha1.AvailabilityCollection) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "updateInstanceHealthConditions")
+
+	for _, availabilityInstance := range availabilityCollection.Status.Instances {
+		condition, ok := healthCondition(&availabilityInstance)
+		if !ok {
+			continue
+		}
+
+		instance := &lssv1alpha1.Instance{}
+		if err := c.Client().Get(ctx, availabilityInstance.NamespacedName(), instance); err != nil {
+			if !apierrors.IsNotFound(err) {
+				logger.Error(err, "unable to get instance", lc.KeyResource, availabilityInstance.NamespacedName().String())
+			}
+			continue
+		}
+
+		oldConditions := append([]v1.Condition{}, instance.Status.Conditions...)
+		condition.ObservedGeneration = instance.GetGeneration()
+		if !meta.SetStatusCondition(&instance.Status.Conditions, condition) {
+			continue
+		}
+
+		if err := c.Client().Status().Update(ctx, instance); err != nil {
+			logger.Info("unable to update health condition of instance", lc.KeyResource, availabilityInstance.NamespacedName().String(), lc.KeyError, err.Error())
+			continue
+		}
+
+		utils.RecordConditionEvents(c.EventRecorder(), instance, oldConditions, instance.Status.Conditions)
+	}
+}
+
+// healthCondition returns the Healthy condition for the given availability status.
+func healthCondition(availabilityInstance *lssv1alpha1.AvailabilityInstance) (v1.Condition, bool) {
+	switch availabilityInstance.Status {
+	case string(lsv1alpha1.LsHealthCheckStatusOk):
+		message := "landscaper is healthy"
+		if len(availabilityInstance.FailedReason) > 0 {
+			message = availabilityInstance.FailedReason
+		}
+		return v1.Condition{
+			Type:    lssv1alpha1.InstanceConditionHealthy,
+			Status:  v1.ConditionTrue,
+			Reason:  "HealthCheckSucceeded",
+			Message: message,
+		}, true
+	case string(lsv1alpha1.LsHealthCheckStatusFailed):
+		return v1.Condition{
+			Type:    lssv1alpha1.InstanceConditionHealthy,
+			Status:  v1.ConditionFalse,
+			Reason:  "HealthCheckFailed",
+			Message: availabilityInstance.FailedReason,
+		}, true
+	default:
+		return v1.Condition{}, false
+	}
+}
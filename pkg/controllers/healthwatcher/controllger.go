@@ -20,6 +20,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -44,12 +45,13 @@ type ServiceTargetConfigKubeClientExtractorInterface interface {
 	GetKubeClientFromServiceTargetConfig(ctx context.Context, name string, namespace string, client client.Client) (client.Client, error)
 }
 
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log: logger,
 	}
 
 	op := operation.NewOperation(c, scheme, config)
+	op.SetEventRecorder(eventRecorder)
 	ctrl.Operation = *op
 	ctrl.kubeClientExtractor = &ServiceTargetConfigKubeClientExtractor{}
 	return ctrl, nil
@@ -100,6 +102,12 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			return reconcile.Result{}, err
 		}
 
+		//skip hibernated instances, since their landscaper is not running
+		if instance.Status.Hibernated {
+			logger.Debug("skip instance since it is hibernated")
+			continue
+		}
+
 		availabilityInstance := c.createAvailabilityInstance(instance, oldInstances...)
 
 		//get referred installation
@@ -184,6 +192,8 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	availabilityCollection.Status.LastRun = v1.NewTime(time.Now())
 
 	logFailedInstances(logger, *availabilityCollection)
+	c.updateInstanceHealthConditions(ctx, availabilityCollection)
+	updateAvailabilityMetrics(oldInstances, availabilityCollection)
 
 	//write to status
 	logger.Debug("updating status")
@@ -342,14 +352,15 @@ func (e *ServiceTargetConfigKubeClientExtractor) GetKubeClientFromServiceTargetC
 		return nil, fmt.Errorf("could not load secret %s:%s for ServiceTargetConfig %s:%s: %w", serviceTargetConfig.Spec.SecretRef.Name, serviceTargetConfig.Spec.SecretRef.Namespace, name, namespace, err)
 	}
 
-	_, targetClient, _, err := getKubeClientFromSecret(*secretWithKubeconf, serviceTargetConfig.Spec.SecretRef.Key)
+	_, targetClient, _, err := GetKubeClientFromSecret(*secretWithKubeconf, serviceTargetConfig.Spec.SecretRef.Key)
 	if err != nil {
 		return nil, fmt.Errorf("failed building kubeclient for target: %w", err)
 	}
 	return targetClient, nil
 }
 
-func getKubeClientFromSecret(secret corev1.Secret, key string) (*rest.Config, client.Client, kubernetes.Interface, error) {
+// GetKubeClientFromSecret builds a rest config, a client and a clientset from the kubeconfig stored in the given key of the secret.
+func GetKubeClientFromSecret(secret corev1.Secret, key string) (*rest.Config, client.Client, kubernetes.Interface, error) {
 	kubeconfigBytes, ok := secret.Data[key]
 	if !ok {
 		return nil, nil, nil, fmt.Errorf("could not found key %s in secret", key)
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthwatcher
+
+import (
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/metrics"
+)
+
+// updateAvailabilityMetrics exposes the availability status of the monitored instances and of the self landscaper as metrics.
+// The metrics of instances which are no longer monitored are removed.
+func updateAvailabilityMetrics(oldInstances []lssv1alpha1.AvailabilityInstance, availabilityCollection *lssv1alpha1.AvailabilityCollection) {
+	monitored := map[string]bool{}
+	for _, availabilityInstance := range availabilityCollection.Status.Instances {
+		monitored[availabilityInstance.NamespacedName().String()] = true
+		metrics.SetInstanceAvailability(availabilityInstance.Namespace, availabilityInstance.Name, availabilityInstance.Status)
+	}
+
+	for _, availabilityInstance := range oldInstances {
+		if !monitored[availabilityInstance.NamespacedName().String()] {
+			metrics.InstanceAvailability.DeleteLabelValues(availabilityInstance.Namespace, availabilityInstance.Name)
+		}
+	}
+
+	metrics.SetSelfAvailability(availabilityCollection.Status.Self.Status)
+}
@@ -11,6 +11,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -256,6 +257,15 @@ var _ = Describe("Reconcile", func() {
 		Expect(availabilityCollection.Status.Instances[1].FailedReason).To(ContainSubstring("timeout - failed recovering from failed state within time"))
 		Expect(availabilityCollection.Status.Instances[0].FailedSince).To(BeNil())
 		Expect(availabilityCollection.Status.Instances[1].FailedSince).ToNot(BeNil())
+
+		instance := &lssv1alpha1.Instance{}
+		Expect(testenv.Client.Get(ctx, availabilityCollection.Status.Instances[0].NamespacedName(), instance)).To(Succeed())
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.InstanceConditionHealthy)).To(BeTrue())
+		Expect(testenv.Client.Get(ctx, availabilityCollection.Status.Instances[1].NamespacedName(), instance)).To(Succeed())
+		healthy := meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.InstanceConditionHealthy)
+		Expect(healthy).ToNot(BeNil())
+		Expect(healthy.Status).To(Equal(v1.ConditionFalse))
+		Expect(healthy.Message).To(ContainSubstring("problems"))
 	})
 
 	It("should collect lshealthcheck from 2 successful but one timeouted instance", func() {
@@ -21,7 +21,7 @@ import (
 // AddControllerToManager adds the instances controller to the manager
 func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.LandscaperServiceConfiguration) error {
 	log := logger.Reconciles("instance", "Instance")
-	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), config)
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("landscaper-service-instances"), config)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances
+
+import (
+	"fmt"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// setCondition sets a status condition of the instance.
+func setCondition(instance *lssv1alpha1.Instance, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: instance.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// setInstallationCondition sets the InstallationSucceeded condition according to the phase of the installation.
+func setInstallationCondition(instance *lssv1alpha1.Instance, installation *lsv1alpha1.Installation) {
+	phase := installation.Status.InstallationPhase
+
+	switch phase {
+	case lsv1alpha1.InstallationPhases.Succeeded:
+		setCondition(instance, lssv1alpha1.InstanceConditionInstallationSucceeded, metav1.ConditionTrue,
+			"InstallationSucceeded", "installation has succeeded")
+	case lsv1alpha1.InstallationPhases.Failed, lsv1alpha1.InstallationPhases.DeleteFailed:
+		message := fmt.Sprintf("installation is in phase %s", phase)
+		if installation.Status.LastError != nil && len(installation.Status.LastError.Message) > 0 {
+			message = fmt.Sprintf("%s: %s", message, installation.Status.LastError.Message)
+		}
+		setCondition(instance, lssv1alpha1.InstanceConditionInstallationSucceeded, metav1.ConditionFalse,
+			"InstallationFailed", message)
+	default:
+		message := "installation has not been processed yet"
+		if len(phase) > 0 {
+			message = fmt.Sprintf("installation is in phase %s", phase)
+		}
+		setCondition(instance, lssv1alpha1.InstanceConditionInstallationSucceeded, metav1.ConditionUnknown,
+			"InstallationProgressing", message)
+	}
+}
+
+// setExportConditions sets the ShootReady and KubeconfigExported conditions according to the exports of the installation.
+func setExportConditions(instance *lssv1alpha1.Instance) {
+	if len(instance.Status.ClusterEndpoint) > 0 {
+		setCondition(instance, lssv1alpha1.InstanceConditionShootReady, metav1.ConditionTrue,
+			"ClusterEndpointExported", fmt.Sprintf("shoot %s/%s is ready", instance.Status.ShootNamespace, instance.Status.ShootName))
+	} else {
+		setCondition(instance, lssv1alpha1.InstanceConditionShootReady, metav1.ConditionUnknown,
+			"ClusterEndpointPending", fmt.Sprintf("waiting for the cluster endpoint of shoot %s/%s", instance.Status.ShootNamespace, instance.Status.ShootName))
+	}
+
+	if instance.Status.UserKubeconfigSecretRef != nil && instance.Status.AdminKubeconfigSecretRef != nil {
+		setCondition(instance, lssv1alpha1.InstanceConditionKubeconfigExported, metav1.ConditionTrue,
+			"KubeconfigExported", "user and admin kubeconfig have been exported")
+	} else {
+		setCondition(instance, lssv1alpha1.InstanceConditionKubeconfigExported, metav1.ConditionUnknown,
+			"KubeconfigPending", "waiting for the user and admin kubeconfig to be exported")
+	}
+}
+
+// readyConditionTypes returns the condition types which must be True for the instance to be ready.
+func readyConditionTypes(instance *lssv1alpha1.Instance) []string {
+	conditionTypes := []string{
+		lssv1alpha1.InstanceConditionContextReady,
+		lssv1alpha1.InstanceConditionTargetReady,
+		lssv1alpha1.InstanceConditionInstallationSucceeded,
+	}
+	if instance.IsInternalDataPlane() {
+		conditionTypes = append(conditionTypes,
+			lssv1alpha1.InstanceConditionShootReady,
+			lssv1alpha1.InstanceConditionKubeconfigExported)
+	}
+	return conditionTypes
+}
+
+// setReadyCondition summarizes the conditions of the instance in the Ready condition.
+func setReadyCondition(instance *lssv1alpha1.Instance) {
+	for _, conditionType := range readyConditionTypes(instance) {
+		condition := meta.FindStatusCondition(instance.Status.Conditions, conditionType)
+		if condition == nil {
+			setCondition(instance, lssv1alpha1.InstanceConditionReady, metav1.ConditionUnknown,
+				"Progressing", fmt.Sprintf("waiting for condition %s", conditionType))
+			return
+		}
+		if condition.Status == metav1.ConditionFalse {
+			setCondition(instance, lssv1alpha1.InstanceConditionReady, metav1.ConditionFalse,
+				condition.Reason, fmt.Sprintf("%s: %s", conditionType, condition.Message))
+			return
+		}
+		if condition.Status != metav1.ConditionTrue {
+			setCondition(instance, lssv1alpha1.InstanceConditionReady, metav1.ConditionUnknown,
+				condition.Reason, fmt.Sprintf("%s: %s", conditionType, condition.Message))
+			return
+		}
+	}
+
+	setCondition(instance, lssv1alpha1.InstanceConditionReady, metav1.ConditionTrue, "InstanceReady", "instance is ready")
+}
@@ -13,9 +13,12 @@ import (
 
 	guuid "github.com/google/uuid"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -74,7 +77,7 @@ func defaultUniqueIdFunc() string {
 }
 
 // NewController returns a new instances controller
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log:                 logger,
 		UniqueIDFunc:        defaultUniqueIdFunc,
@@ -84,6 +87,7 @@ func NewController(logger logging.Logger, c client.Client, scheme *runtime.Schem
 	ctrl.HandleDeleteFunc = ctrl.handleDelete
 	ctrl.ListShootsFunc = ctrl.listShoots
 	op := operation.NewOperation(c, scheme, config)
+	op.SetEventRecorder(eventRecorder)
 	ctrl.Operation = *op
 	return ctrl, nil
 }
@@ -148,6 +152,11 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return result, errHdl(ctx, err)
 	}
 
+	if utils.IsOrphaned(instance) {
+		logger.Info("instance is orphaned, skipping reconcile")
+		return computeAutomaticReconcile(instance, c.updateOrphanedStatus(ctx, instance))
+	}
+
 	if utils.HasOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationIgnore) {
 		logger.Info("instance has ignore annotation, skipping reconcile")
 		return computeAutomaticReconcile(instance, nil)
@@ -163,6 +172,8 @@ func (c *Controller) handleErrorFunc(instance *lssv1alpha1.Instance) func(ctx co
 	return func(ctx context.Context, err error) error {
 		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()})
 		instance.Status.LastError = lsserrors.TryUpdateError(instance.Status.LastError, err)
+		utils.RecordErrorEvent(c.EventRecorder(), instance, "ReconcileFailed", err)
+		utils.RecordConditionEvents(c.EventRecorder(), instance, old.Status.Conditions, instance.Status.Conditions)
 
 		if !reflect.DeepEqual(old.Status, instance.Status) {
 			if err2 := c.Client().Status().Update(ctx, instance); err2 != nil {
@@ -183,11 +194,31 @@ func (c *Controller) handleErrorFunc(instance *lssv1alpha1.Instance) func(ctx co
 	}
 }
 
+// updateOrphanedStatus records in the error status of an instance that its service target config has been deleted.
+func (c *Controller) updateOrphanedStatus(ctx context.Context, instance *lssv1alpha1.Instance) error {
+	msg := fmt.Sprintf("service target config %s has been deleted", instance.Annotations[lssv1alpha1.LandscaperServiceOrphanedAnnotation])
+	if instance.Status.LastError != nil && instance.Status.LastError.Reason == "Orphaned" && instance.Status.LastError.Message == msg {
+		return nil
+	}
+
+	instance.Status.LastError = lsserrors.UpdatedError(instance.Status.LastError, "Reconcile", "Orphaned", msg)
+	setCondition(instance, lssv1alpha1.InstanceConditionReady, metav1.ConditionFalse, "Orphaned", msg)
+	c.EventRecorder().Event(instance, corev1.EventTypeWarning, "Orphaned", msg)
+	return c.Client().Status().Update(ctx, instance)
+}
+
 func computeAutomaticReconcile(instance *lssv1alpha1.Instance, reconcileError error) (reconcile.Result, error) {
 	reconcileInterval := AutomaticReconcileDefaultDuration
 	if instance.Spec.AutomaticReconcile != nil {
 		reconcileInterval = instance.Spec.AutomaticReconcile.Interval.Duration
 	}
+	if instance.IsMigrating() {
+		reconcileInterval = migrationRequeueDuration
+	}
+	if d, ok := utils.DurationUntilNextHibernationTransition(instance.Spec.Hibernation, time.Now()); ok && d < reconcileInterval {
+		// reconcile the instance when it has to be hibernated or woken up
+		reconcileInterval = d
+	}
 
 	if reconcileError == nil {
 		return reconcile.Result{
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsinstallation "github.com/gardener/landscaper-service/pkg/apis/installation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// initiateCredentialsRotation initiates the rotation of the credentials, if the instance has the rotate-credentials operation annotation.
+// The initiation time is recorded in the instance status and the annotation is removed.
+// The changed rotation config of the installation forces the regeneration of the service account tokens and kubeconfigs.
+func (c *Controller) initiateCredentialsRotation(ctx context.Context, instance *lssv1alpha1.Instance) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
+		lc.KeyMethod, "initiateCredentialsRotation")
+
+	if !utils.HasOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationRotateCredentials) {
+		return nil
+	}
+
+	logger.Info("Initiating credentials rotation")
+	instance.Status.CredentialsRotation = &lssv1alpha1.CredentialsRotationStatus{
+		LastInitiationTime: metav1.NewTime(time.Now().Truncate(time.Second)),
+	}
+	if err := c.Client().Status().Update(ctx, instance); err != nil {
+		return fmt.Errorf("unable to update credentials rotation status: %w", err)
+	}
+
+	utils.RemoveOperationAnnotation(instance)
+	if err := c.Client().Update(ctx, instance); err != nil {
+		return fmt.Errorf("unable to remove rotate-credentials operation annotation: %w", err)
+	}
+
+	c.EventRecorder().Event(instance, corev1.EventTypeNormal, "CredentialsRotationStarted", "rotation of the credentials has been initiated")
+	return nil
+}
+
+// newRotationConfig creates the rotation config of the installation of an instance.
+func (c *Controller) newRotationConfig(instance *lssv1alpha1.Instance) *lsinstallation.RotationConfig {
+	rotationConfig := lsinstallation.NewRotationConfig(
+		int64(c.Config().CredentialRotation.TokenExpiration.Seconds()),
+		int64(c.Config().CredentialRotation.AdminKubeconfigExpiration.Seconds()))
+
+	if instance.Status.CredentialsRotation != nil {
+		rotationConfig.WithRotationTimestamp(instance.Status.CredentialsRotation.LastInitiationTime.UTC().Format(time.RFC3339))
+	}
+
+	return rotationConfig
+}
+
+// updateCredentialsRotationStatus records the completion of a credentials rotation,
+// when the installation has successfully been reconciled with the new rotation config.
+func (c *Controller) updateCredentialsRotationStatus(instance *lssv1alpha1.Instance, installation *lsv1alpha1.Installation) {
+	if !instance.IsRotatingCredentials() {
+		return
+	}
+
+	if installation.Annotations[lsv1alpha1.OperationAnnotation] == string(lsv1alpha1.ReconcileOperation) ||
+		installation.Status.JobID != installation.Status.JobIDFinished ||
+		installation.Status.InstallationPhase != lsv1alpha1.InstallationPhases.Succeeded {
+		return
+	}
+
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	instance.Status.CredentialsRotation.LastCompletionTime = &now
+	c.EventRecorder().Event(instance, corev1.EventTypeNormal, "CredentialsRotationSucceeded", "credentials have been rotated")
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+
+	lssconfig "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsinstallation "github.com/gardener/landscaper-service/pkg/apis/installation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// reconcileHibernation determines whether the instance is hibernated at the current time and records it in the instance status.
+// The status is persisted together with the conditions of the instance.
+func (c *Controller) reconcileHibernation(instance *lssv1alpha1.Instance) error {
+	hibernated, err := utils.IsHibernated(instance.Spec.Hibernation, time.Now())
+	if err != nil {
+		return fmt.Errorf("unable to evaluate hibernation: %w", err)
+	}
+
+	if hibernated == instance.Status.Hibernated {
+		return nil
+	}
+
+	instance.Status.Hibernated = hibernated
+	if hibernated {
+		c.EventRecorder().Event(instance, corev1.EventTypeNormal, "Hibernating", "instance is being hibernated")
+	} else {
+		c.EventRecorder().Event(instance, corev1.EventTypeNormal, "WakingUp", "instance is being woken up")
+	}
+	return nil
+}
+
+// newShootHibernation creates the hibernation configuration of the shoot cluster of an instance.
+// The shoot cluster of a hibernated instance is only hibernated, after the installation has successfully scaled down the
+// landscaper and the sidecar, and has switched the sub-installations which deploy into the shoot cluster to the hibernated mode.
+// Otherwise, these sub-installations would fail, since they access the api server of the shoot cluster while it is shut down.
+// The schedules are not passed to the shoot cluster, so that it is only hibernated in this order.
+func newShootHibernation(instance *lssv1alpha1.Instance, installation *lsv1alpha1.Installation) *lssconfig.ShootHibernation {
+	shootHibernated := isShootHibernated(installation)
+	if instance.Spec.Hibernation == nil && !instance.Status.Hibernated && !shootHibernated {
+		return nil
+	}
+
+	return &lssconfig.ShootHibernation{
+		Enabled: instance.Status.Hibernated && (shootHibernated || isLandscaperHibernated(installation)),
+	}
+}
+
+// isShootHibernated returns whether the hibernation of the shoot cluster is enabled in the given installation.
+func isShootHibernated(installation *lsv1alpha1.Installation) bool {
+	shootConfig := &lssconfig.ShootConfiguration{}
+	if !unmarshalImportDataMapping(installation, lsinstallation.ShootConfigImportName, shootConfig) {
+		return false
+	}
+	return shootConfig.Hibernation != nil && shootConfig.Hibernation.Enabled
+}
+
+// isLandscaperHibernated returns whether the given installation has successfully been reconciled with a hibernated landscaper.
+func isLandscaperHibernated(installation *lsv1alpha1.Installation) bool {
+	landscaperConfig := &lsinstallation.LandscaperConfig{}
+	if !unmarshalImportDataMapping(installation, lsinstallation.LandscaperConfigImportName, landscaperConfig) {
+		return false
+	}
+	return landscaperConfig.Hibernated && isInstallationSucceeded(installation)
+}
+
+// unmarshalImportDataMapping unmarshals the import data mapping with the given name of the installation.
+// False is returned if the installation has no such import data mapping or it can't be unmarshalled.
+func unmarshalImportDataMapping(installation *lsv1alpha1.Installation, name string, obj any) bool {
+	data, ok := installation.Spec.ImportDataMappings[name]
+	if !ok {
+		return false
+	}
+	return json.Unmarshal(data.RawMessage, obj) == nil
+}
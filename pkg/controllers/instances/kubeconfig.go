@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+const (
+	// kubeconfigSecretKey is the key of the kubeconfig in the user and admin kubeconfig secrets.
+	kubeconfigSecretKey = "kubeconfig"
+)
+
+// getUserKubeconfigSecretName returns the name of the secret which contains the user kubeconfig of the instance.
+func getUserKubeconfigSecretName(instance *lssv1alpha1.Instance) string {
+	if len(instance.Spec.UserKubeconfigSecretName) > 0 {
+		return instance.Spec.UserKubeconfigSecretName
+	}
+	return fmt.Sprintf("%s-user-kubeconfig", instance.GetName())
+}
+
+// getAdminKubeconfigSecretName returns the name of the secret which contains the admin kubeconfig of the instance.
+func getAdminKubeconfigSecretName(instance *lssv1alpha1.Instance) string {
+	return fmt.Sprintf("%s-admin-kubeconfig", instance.GetName())
+}
+
+// reconcileKubeconfigSecrets writes the exported user and admin kubeconfigs into the kubeconfig secrets of the instance.
+// The kubeconfigs of instances, which have been created before the kubeconfigs were delivered as secrets,
+// are migrated from the deprecated status fields, which are cleared afterwards.
+func (c *Controller) reconcileKubeconfigSecrets(ctx context.Context, instance *lssv1alpha1.Instance, userKubeconfig, adminKubeconfig string) error {
+	userSecretName := getUserKubeconfigSecretName(instance)
+	adminSecretName := getAdminKubeconfigSecretName(instance)
+	if userSecretName == adminSecretName {
+		return fmt.Errorf("user kubeconfig secret name %q is reserved for the admin kubeconfig", userSecretName)
+	}
+
+	kubeconfig, err := c.getKubeconfig(ctx, userKubeconfig, instance.Status.UserKubeconfig, instance.Status.UserKubeconfigSecretRef)
+	if err != nil {
+		return fmt.Errorf("unable to get user kubeconfig: %w", err)
+	}
+	if len(kubeconfig) > 0 {
+		secretRef, err := c.reconcileKubeconfigSecret(ctx, instance, instance.Status.UserKubeconfigSecretRef, userSecretName, kubeconfig)
+		if err != nil {
+			return fmt.Errorf("unable to reconcile user kubeconfig secret: %w", err)
+		}
+		instance.Status.UserKubeconfigSecretRef = secretRef
+		instance.Status.UserKubeconfig = ""
+	}
+
+	kubeconfig, err = c.getKubeconfig(ctx, adminKubeconfig, instance.Status.AdminKubeconfig, instance.Status.AdminKubeconfigSecretRef)
+	if err != nil {
+		return fmt.Errorf("unable to get admin kubeconfig: %w", err)
+	}
+	if len(kubeconfig) > 0 {
+		secretRef, err := c.reconcileKubeconfigSecret(ctx, instance, instance.Status.AdminKubeconfigSecretRef, adminSecretName, kubeconfig)
+		if err != nil {
+			return fmt.Errorf("unable to reconcile admin kubeconfig secret: %w", err)
+		}
+		instance.Status.AdminKubeconfigSecretRef = secretRef
+		instance.Status.AdminKubeconfig = ""
+	}
+
+	return nil
+}
+
+// getKubeconfig returns the decoded kubeconfig of the installation export.
+// If the kubeconfig has not been exported, it is taken from the deprecated status field or from the current kubeconfig secret.
+func (c *Controller) getKubeconfig(ctx context.Context, exportedKubeconfig, statusKubeconfig string, secretRef *lssv1alpha1.SecretReference) ([]byte, error) {
+	encodedKubeconfig := exportedKubeconfig
+	if len(encodedKubeconfig) == 0 {
+		encodedKubeconfig = statusKubeconfig
+	}
+
+	if len(encodedKubeconfig) > 0 {
+		kubeconfig, err := base64.StdEncoding.DecodeString(encodedKubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode kubeconfig: %w", err)
+		}
+		return kubeconfig, nil
+	}
+
+	if secretRef == nil {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Client().Get(ctx, secretRef.NamespacedName(), secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get kubeconfig secret %s: %w", secretRef.NamespacedName().String(), err)
+	}
+	return secret.Data[secretRef.Key], nil
+}
+
+// reconcileKubeconfigSecret creates or updates the secret with the given name, which contains the given kubeconfig.
+// If the secret name has changed, the previously referenced secret is deleted.
+func (c *Controller) reconcileKubeconfigSecret(ctx context.Context, instance *lssv1alpha1.Instance, oldSecretRef *lssv1alpha1.SecretReference, name string, kubeconfig []byte) (*lssv1alpha1.SecretReference, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
+		lc.KeyMethod, "reconcileKubeconfigSecret")
+
+	secret := &corev1.Secret{}
+	secret.Name = name
+	secret.Namespace = instance.GetNamespace()
+
+	result, err := kubernetes.CreateOrUpdate(ctx, c.Client(), secret, func() error {
+		if !secret.CreationTimestamp.IsZero() && !metav1.IsControlledBy(secret, instance) {
+			return fmt.Errorf("secret %s already exists and is not controlled by the instance", client.ObjectKeyFromObject(secret).String())
+		}
+		if err := controllerutil.SetControllerReference(instance, secret, c.Scheme()); err != nil {
+			return fmt.Errorf("unable to set controller reference for secret: %w", err)
+		}
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{
+			kubeconfigSecretKey: kubeconfig,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create/update secret: %w", err)
+	}
+
+	if result == controllerutil.OperationResultCreated {
+		logger.Info("created kubeconfig secret", lc.KeyResource, client.ObjectKeyFromObject(secret).String())
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "KubeconfigSecretCreated",
+			"created kubeconfig secret %s", client.ObjectKeyFromObject(secret).String())
+	}
+
+	if oldSecretRef != nil && !oldSecretRef.IsObject(secret) {
+		if err := c.deleteKubeconfigSecret(ctx, instance, oldSecretRef); err != nil {
+			return nil, err
+		}
+	}
+
+	return &lssv1alpha1.SecretReference{
+		ObjectReference: lssv1alpha1.ObjectReference{
+			Name:      secret.GetName(),
+			Namespace: secret.GetNamespace(),
+		},
+		Key: kubeconfigSecretKey,
+	}, nil
+}
+
+// deleteKubeconfigSecret deletes the referenced kubeconfig secret, if it is controlled by the instance.
+func (c *Controller) deleteKubeconfigSecret(ctx context.Context, instance *lssv1alpha1.Instance, secretRef *lssv1alpha1.SecretReference) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
+		lc.KeyMethod, "deleteKubeconfigSecret")
+
+	secret := &corev1.Secret{}
+	if err := c.Client().Get(ctx, secretRef.NamespacedName(), secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to get kubeconfig secret %s: %w", secretRef.NamespacedName().String(), err)
+	}
+
+	if !metav1.IsControlledBy(secret, instance) {
+		return nil
+	}
+
+	logger.Info("deleting kubeconfig secret", lc.KeyResource, secretRef.NamespacedName().String())
+	if err := c.Client().Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete kubeconfig secret %s: %w", secretRef.NamespacedName().String(), err)
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -48,48 +49,88 @@ const (
 	// shootKind is the gardener shoot kind
 	shootKind = "Shoot"
 	// automaticReconcileSeconds is the number of seconds after which installations of landscaper instances are
-	// automatically reconciled. Important: the value must be shorter than tokenExpirationSeconds
+	// automatically reconciled. Important: the value must be shorter than the configured token expiration
 	automaticReconcileSeconds = 14 * 24 * 60 * 60
 	// failedReconcileSeconds is the number of seconds after which a failed landscaper instance is automatically reconciled.
 	failedReconcileSeconds = 60 * 10
-	// tokenExpirationSeconds defines how long the tokens are valid	which the landscaper and sidecar controllers use
-	// to access the resource cluster, e.g. for watching installations, namespace registrations etc.
-	// Important: the value must be larger than automaticReconcileSeconds.
-	tokenExpirationSeconds = int64(90 * 24 * 60 * 60)
-	// adminKubeconfigExpirationSeconds defines how long the admin kubeconfig for a resource cluster is valid.
-	// This kubeconfig is used to deploy RBAC objects on the resource cluster. Maximum: 86400 (1 day).
-	// Each reconcile uses a new kubeconfig, so that the short duration suffices.
-	adminKubeconfigExpirationSeconds = int64(24 * 60 * 60)
 )
 
+// InstallationAutomaticReconcileInterval is the interval after which installations of landscaper instances are automatically reconciled.
+// The service account tokens of the instances are renewed with each reconciliation, so that the token expiration must be longer.
+var InstallationAutomaticReconcileInterval = time.Duration(automaticReconcileSeconds) * time.Second
+
 // reconcile reconciles an instance.
 func (c *Controller) reconcile(ctx context.Context, instance *lssv1alpha1.Instance) error {
 	currOp := "Reconcile"
 
+	// the ready condition is persisted together with the other conditions by the error handler
+	defer setReadyCondition(instance)
+
 	if err := c.reconcileContext(ctx, instance); err != nil {
+		setCondition(instance, lssv1alpha1.InstanceConditionContextReady, metav1.ConditionFalse, "ReconcileContextFailed", err.Error())
 		return errors.NewWrappedError(err, currOp, "ReconcileContextFailed", err.Error())
 	}
+	setCondition(instance, lssv1alpha1.InstanceConditionContextReady, metav1.ConditionTrue, "ContextReconciled", "context has been reconciled")
 
 	if instance.IsInternalDataPlane() {
 		if err := c.reconcileGardenerServiceAccountTarget(ctx, instance); err != nil {
+			setCondition(instance, lssv1alpha1.InstanceConditionTargetReady, metav1.ConditionFalse, "ReconcileGardenerServiceAccountTargetFailed", err.Error())
 			return errors.NewWrappedError(err, currOp, "ReconcileGardenerServiceAccountTargetFailed", err.Error())
 		}
 	}
 
 	if instance.IsExternalDataPlane() {
 		if err := c.reconcileExternalDataPlaneClusterTarget(ctx, instance); err != nil {
+			setCondition(instance, lssv1alpha1.InstanceConditionTargetReady, metav1.ConditionFalse, "ReconcileExternalDataPlaneClusterTarget", err.Error())
 			return errors.NewWrappedError(err, currOp, "ReconcileExternalDataPlaneClusterTarget", err.Error())
 		}
 	}
 
 	if err := c.reconcileTarget(ctx, instance); err != nil {
+		setCondition(instance, lssv1alpha1.InstanceConditionTargetReady, metav1.ConditionFalse, "ReconcileTargetFailed", err.Error())
 		return errors.NewWrappedError(err, currOp, "ReconcileTargetFailed", err.Error())
 	}
+	setCondition(instance, lssv1alpha1.InstanceConditionTargetReady, metav1.ConditionTrue, "TargetReconciled", "targets have been reconciled")
+
+	if err := c.prepareMigration(ctx, instance); err != nil {
+		return errors.NewWrappedError(err, currOp, "PrepareMigrationFailed", err.Error())
+	}
+
+	if err := c.initiateCredentialsRotation(ctx, instance); err != nil {
+		return errors.NewWrappedError(err, currOp, "RotateCredentialsFailed", err.Error())
+	}
+
+	if err := c.reconcileHibernation(instance); err != nil {
+		return errors.NewWrappedError(err, currOp, "ReconcileHibernationFailed", err.Error())
+	}
+
+	supportedVersion, err := c.getInstallationVersion(instance)
+	if err != nil {
+		setCondition(instance, lssv1alpha1.InstanceConditionInstallationSucceeded, metav1.ConditionFalse, "UnsupportedVersion", err.Error())
+		return errors.NewWrappedError(err, currOp, "UnsupportedVersion", err.Error())
+	}
+	// the condition events are only recorded when the condition status changes
+	if supportedVersion.IsDeprecated(time.Now()) {
+		setCondition(instance, lssv1alpha1.InstanceConditionVersionSupported, metav1.ConditionFalse, "VersionDeprecated",
+			fmt.Sprintf("version %s of the landscaper service component is deprecated since %s", supportedVersion.Version, supportedVersion.DeprecationDate.Format(time.DateOnly)))
+	} else {
+		setCondition(instance, lssv1alpha1.InstanceConditionVersionSupported, metav1.ConditionTrue, "VersionSupported",
+			fmt.Sprintf("version %s of the landscaper service component is supported", supportedVersion.Version))
+	}
 
-	if err := c.reconcileInstallation(ctx, instance); err != nil {
+	if instance.IsInternalDataPlane() {
+		c.setShootProfileCondition(instance)
+	}
+
+	if err := c.reconcileInstallation(ctx, instance, supportedVersion); err != nil {
+		setCondition(instance, lssv1alpha1.InstanceConditionInstallationSucceeded, metav1.ConditionFalse, "ReconcileInstallationFailed", err.Error())
 		return errors.NewWrappedError(err, currOp, "ReconcileInstallationFailed", err.Error())
 	}
 
+	if err := c.reconcileMigration(ctx, instance); err != nil {
+		return errors.NewWrappedError(err, currOp, "ReconcileMigrationFailed", err.Error())
+	}
+
 	return nil
 }
 
@@ -104,7 +145,7 @@ func (c *Controller) reconcileContext(ctx context.Context, instance *lssv1alpha1
 		landscaperContext.Namespace = instance.Status.ContextRef.Namespace
 	}
 
-	_, err := kubernetes.CreateOrUpdate(ctx, c.Client(), landscaperContext, func() error {
+	result, err := kubernetes.CreateOrUpdate(ctx, c.Client(), landscaperContext, func() error {
 		return c.mutateContext(ctx, landscaperContext, instance)
 	})
 
@@ -112,6 +153,11 @@ func (c *Controller) reconcileContext(ctx context.Context, instance *lssv1alpha1
 		return fmt.Errorf("unable to create/update landscaperContext: %w", err)
 	}
 
+	if result == controllerutil.OperationResultCreated {
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "ContextCreated",
+			"created context %s", client.ObjectKeyFromObject(landscaperContext).String())
+	}
+
 	if instance.Status.ContextRef == nil || !instance.Status.ContextRef.IsObject(landscaperContext) {
 		instance.Status.ContextRef = &lssv1alpha1.ObjectReference{
 			Name:      landscaperContext.GetName(),
@@ -190,7 +236,7 @@ func (c *Controller) reconcileTarget(ctx context.Context, instance *lssv1alpha1.
 		target.Namespace = instance.Status.TargetRef.Namespace
 	}
 
-	_, err := kubernetes.CreateOrUpdate(ctx, c.Client(), target, func() error {
+	result, err := kubernetes.CreateOrUpdate(ctx, c.Client(), target, func() error {
 		return c.mutateTarget(ctx, target, instance)
 	})
 
@@ -198,6 +244,11 @@ func (c *Controller) reconcileTarget(ctx context.Context, instance *lssv1alpha1.
 		return fmt.Errorf("unable to create/update target: %w", err)
 	}
 
+	if result == controllerutil.OperationResultCreated {
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "TargetCreated",
+			"created target %s", client.ObjectKeyFromObject(target).String())
+	}
+
 	if instance.Status.TargetRef == nil || !instance.Status.TargetRef.IsObject(target) {
 		instance.Status.TargetRef = &lssv1alpha1.ObjectReference{
 			Name:      target.GetName(),
@@ -422,7 +473,7 @@ func (c *Controller) mutateExternalDataPlaneClusterTarget(ctx context.Context, t
 }
 
 // reconcileInstallation reconciles the installation for an instance
-func (c *Controller) reconcileInstallation(ctx context.Context, instance *lssv1alpha1.Instance) error {
+func (c *Controller) reconcileInstallation(ctx context.Context, instance *lssv1alpha1.Instance, supportedVersion *lssconfig.SupportedVersion) error {
 	old := instance.DeepCopy()
 
 	if instance.IsInternalDataPlane() {
@@ -430,6 +481,10 @@ func (c *Controller) reconcileInstallation(ctx context.Context, instance *lssv1a
 			return err
 		}
 
+		if err := c.handleShootRegion(ctx, instance); err != nil {
+			return err
+		}
+
 		if !reflect.DeepEqual(old.Status, instance.Status) {
 			if err := c.Client().Status().Update(ctx, instance); err != nil {
 				return fmt.Errorf("unable to update instance status: %w", err)
@@ -450,18 +505,27 @@ func (c *Controller) reconcileInstallation(ctx context.Context, instance *lssv1a
 		}
 	}
 
-	_, err := kubernetes.CreateOrUpdate(ctx, c.Client(), installation, func() error {
+	result, err := kubernetes.CreateOrUpdate(ctx, c.Client(), installation, func() error {
 		if instance.IsInternalDataPlane() {
-			return c.mutateInstallation(ctx, installation, instance)
+			return c.mutateInstallation(ctx, installation, instance, supportedVersion.Version)
 		}
 
-		return c.mutateInstallationExternalDataPlane(ctx, installation, instance)
+		return c.mutateInstallationExternalDataPlane(ctx, installation, instance, supportedVersion.Version)
 	})
 
 	if err != nil {
 		return fmt.Errorf("unable to create/update installation: %w", err)
 	}
 
+	switch result {
+	case controllerutil.OperationResultCreated:
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "InstallationCreated",
+			"created installation %s", client.ObjectKeyFromObject(installation).String())
+	case controllerutil.OperationResultUpdated:
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "InstallationUpdated",
+			"updated installation %s", client.ObjectKeyFromObject(installation).String())
+	}
+
 	old = instance.DeepCopy()
 	instance.Status.InstallationRef = &lssv1alpha1.ObjectReference{
 		Name:      installation.GetName(),
@@ -469,11 +533,18 @@ func (c *Controller) reconcileInstallation(ctx context.Context, instance *lssv1a
 	}
 
 	instance.Status.LandscaperServiceComponent = &lssv1alpha1.LandscaperServiceComponent{
-		Name:    installation.Spec.ComponentDescriptor.Reference.ComponentName,
-		Version: installation.Spec.ComponentDescriptor.Reference.Version,
+		Name:            installation.Spec.ComponentDescriptor.Reference.ComponentName,
+		Version:         installation.Spec.ComponentDescriptor.Reference.Version,
+		DeprecationDate: supportedVersion.DeprecationDate,
 	}
 
 	if instance.IsInternalDataPlane() {
+		shootConfig, err := c.newShootConfiguration(instance)
+		if err != nil {
+			return err
+		}
+		instance.Status.ShootProfile = c.getAppliedShootProfile(instance)
+		instance.Status.ShootKubernetesVersion = shootConfig.Kubernetes.Version
 		if err := c.handleExports(ctx, instance, installation); err != nil {
 			return err
 		}
@@ -484,6 +555,11 @@ func (c *Controller) reconcileInstallation(ctx context.Context, instance *lssv1a
 	}
 
 	instance.Status.Phase = string(installation.Status.InstallationPhase)
+	setInstallationCondition(instance, installation)
+	c.updateCredentialsRotationStatus(instance, installation)
+	if instance.IsInternalDataPlane() {
+		setExportConditions(instance)
+	}
 
 	if !reflect.DeepEqual(old.Status, instance.Status) {
 		if err := c.Client().Status().Update(ctx, instance); err != nil {
@@ -494,8 +570,23 @@ func (c *Controller) reconcileInstallation(ctx context.Context, instance *lssv1a
 	return nil
 }
 
+// newSidecarConfig creates the sidecar config of the installation of an instance.
+func (c *Controller) newSidecarConfig(instance *lssv1alpha1.Instance) *lsinstallation.SidecarConfig {
+	sidecarConfig := lsinstallation.NewSidecarConfig()
+	sidecarConfig.Hibernated = instance.Status.Hibernated
+
+	if webhooksServer := c.Config().SidecarWebhooksServer; webhooksServer != nil && len(webhooksServer.URL) > 0 {
+		sidecarConfig.WebhooksServer = &lsinstallation.SidecarWebhooksServer{
+			URL:                   webhooksServer.URL,
+			CertificatesNamespace: webhooksServer.CertificatesNamespace,
+		}
+	}
+
+	return sidecarConfig
+}
+
 // mutateInstallation creates or updates the installation for an instance.
-func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1alpha1.Installation, instance *lssv1alpha1.Instance) error {
+func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1alpha1.Installation, instance *lssv1alpha1.Instance, version string) error {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
 		lc.KeyMethod, "mutateInstallation")
 
@@ -529,6 +620,7 @@ func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1a
 	landscaperConfig.HPAMain = instance.Spec.LandscaperConfiguration.HPAMain
 	landscaperConfig.Deployers = instance.Spec.LandscaperConfiguration.Deployers
 	landscaperConfig.DeployersConfig = instance.Spec.LandscaperConfiguration.DeployersConfig
+	landscaperConfig.Hibernated = instance.Status.Hibernated
 	landscaperConfig.Landscaper.Verbosity = logging.INFO.String()
 	if instance.Spec.LandscaperConfiguration.Landscaper != nil {
 		landscaperConfig.Landscaper.Controllers = instance.Spec.LandscaperConfiguration.Landscaper.Controllers
@@ -542,20 +634,22 @@ func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1a
 		return fmt.Errorf("unable to marshal landscaper config: %w", err)
 	}
 
-	sidecarConfig := lsinstallation.NewSidecarConfig()
+	sidecarConfig := c.newSidecarConfig(instance)
 	sidecarConfigRaw, err := sidecarConfig.ToAnyJSON()
 	if err != nil {
 		return fmt.Errorf("unable to marshal sidecar config: %w", err)
 	}
 
-	rotationConfig := lsinstallation.NewRotationConfig(tokenExpirationSeconds, adminKubeconfigExpirationSeconds)
+	rotationConfig := c.newRotationConfig(instance)
 	rotationConfigRaw, err := rotationConfig.ToAnyJSON()
 	if err != nil {
 		return fmt.Errorf("unable to marshal rotation config: %w", err)
 	}
 
-	shootConfig := &lssconfig.ShootConfiguration{}
-	c.Config().ShootConfiguration.DeepCopyInto(shootConfig)
+	shootConfig, err := c.newShootConfiguration(instance)
+	if err != nil {
+		return err
+	}
 
 	if instance.Spec.OIDCConfig != nil {
 		// Overwrite the default oidc config with instance specific values
@@ -575,6 +669,8 @@ func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1a
 		}
 	}
 
+	shootConfig.Hibernation = newShootHibernation(instance, installation)
+
 	shootConfigRaw, err := json.Marshal(shootConfig)
 	if err != nil {
 		return fmt.Errorf("unable to marshal shoot config: %w", err)
@@ -596,7 +692,7 @@ func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1a
 		ComponentDescriptor: &lsv1alpha1.ComponentDescriptorDefinition{
 			Reference: &lsv1alpha1.ComponentDescriptorReference{
 				ComponentName: c.Operation.Config().LandscaperServiceComponent.Name,
-				Version:       c.Operation.Config().LandscaperServiceComponent.Version,
+				Version:       version,
 			},
 		},
 		Blueprint: lsv1alpha1.BlueprintDefinition{
@@ -648,7 +744,7 @@ func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1a
 		},
 		AutomaticReconcile: &lsv1alpha1.AutomaticReconcile{
 			SucceededReconcile: &lsv1alpha1.SucceededReconcile{
-				Interval: &lsv1alpha1.Duration{Duration: time.Duration(automaticReconcileSeconds) * time.Second},
+				Interval: &lsv1alpha1.Duration{Duration: InstallationAutomaticReconcileInterval},
 			},
 			FailedReconcile: &lsv1alpha1.FailedReconcile{
 				Interval: &lsv1alpha1.Duration{Duration: time.Duration(failedReconcileSeconds) * time.Second},
@@ -703,7 +799,7 @@ func (c *Controller) mutateInstallation(ctx context.Context, installation *lsv1a
 }
 
 // mutateInstallation creates or updates the installation for an instance.
-func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, installation *lsv1alpha1.Installation, instance *lssv1alpha1.Instance) error {
+func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, installation *lsv1alpha1.Installation, instance *lssv1alpha1.Instance, version string) error {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
 		lc.KeyMethod, "mutateInstallationExternalDataPlane")
 
@@ -737,6 +833,7 @@ func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, in
 	landscaperConfig.HPAMain = instance.Spec.LandscaperConfiguration.HPAMain
 	landscaperConfig.Deployers = instance.Spec.LandscaperConfiguration.Deployers
 	landscaperConfig.DeployersConfig = instance.Spec.LandscaperConfiguration.DeployersConfig
+	landscaperConfig.Hibernated = instance.Status.Hibernated
 	landscaperConfig.Landscaper.Verbosity = logging.INFO.String()
 	if instance.Spec.LandscaperConfiguration.Landscaper != nil {
 		landscaperConfig.Landscaper.Controllers = instance.Spec.LandscaperConfiguration.Landscaper.Controllers
@@ -750,13 +847,13 @@ func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, in
 		return fmt.Errorf("unable to marshal landscaper config: %w", err)
 	}
 
-	sidecarConfig := lsinstallation.NewSidecarConfig()
+	sidecarConfig := c.newSidecarConfig(instance)
 	sidecarConfigRaw, err := sidecarConfig.ToAnyJSON()
 	if err != nil {
 		return fmt.Errorf("unable to marshal sidecar config: %w", err)
 	}
 
-	rotationConfig := lsinstallation.NewRotationConfig(tokenExpirationSeconds, adminKubeconfigExpirationSeconds)
+	rotationConfig := c.newRotationConfig(instance)
 	rotationConfigRaw, err := rotationConfig.ToAnyJSON()
 	if err != nil {
 		return fmt.Errorf("unable to marshal rotation config: %w", err)
@@ -767,7 +864,7 @@ func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, in
 		ComponentDescriptor: &lsv1alpha1.ComponentDescriptorDefinition{
 			Reference: &lsv1alpha1.ComponentDescriptorReference{
 				ComponentName: c.Operation.Config().LandscaperServiceComponent.Name,
-				Version:       c.Operation.Config().LandscaperServiceComponent.Version,
+				Version:       version,
 			},
 		},
 		Blueprint: lsv1alpha1.BlueprintDefinition{
@@ -798,7 +895,7 @@ func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, in
 		},
 		AutomaticReconcile: &lsv1alpha1.AutomaticReconcile{
 			SucceededReconcile: &lsv1alpha1.SucceededReconcile{
-				Interval: &lsv1alpha1.Duration{Duration: time.Duration(automaticReconcileSeconds) * time.Second},
+				Interval: &lsv1alpha1.Duration{Duration: InstallationAutomaticReconcileInterval},
 			},
 			FailedReconcile: &lsv1alpha1.FailedReconcile{
 				Interval: &lsv1alpha1.Duration{Duration: time.Duration(failedReconcileSeconds) * time.Second},
@@ -819,6 +916,7 @@ func (c *Controller) mutateInstallationExternalDataPlane(ctx context.Context, in
 }
 
 // handleExports tries to find the exports of the installation and update the instance status accordingly.
+// The user and admin kubeconfigs are written into secrets, which are referenced in the instance status.
 func (c *Controller) handleExports(ctx context.Context, instance *lssv1alpha1.Instance, installation *lsv1alpha1.Installation) error {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
 		lc.KeyMethod, "handleExports")
@@ -839,6 +937,8 @@ func (c *Controller) handleExports(ctx context.Context, instance *lssv1alpha1.In
 		return fmt.Errorf("unable to list data objects for ClusterKubeconfig: %w", err)
 	}
 
+	var userKubeconfig, adminKubeconfig string
+
 	if len(dataObjects.Items) > 0 {
 		userKubeconfigExportName := lsinstallation.GetInstallationExportDataRef(instance, lsinstallation.UserKubeconfigExportName)
 		adminKubeconfigExportName := lsinstallation.GetInstallationExportDataRef(instance, lsinstallation.AdminKubeconfigExportName)
@@ -854,13 +954,13 @@ func (c *Controller) handleExports(ctx context.Context, instance *lssv1alpha1.In
 			case userKubeconfigExportName:
 				logger.Info("found export data object for user kubeconfig",
 					lc.KeyResource, types.NamespacedName{Name: do.Name, Namespace: do.Namespace}.String())
-				if err := json.Unmarshal(do.Data.RawMessage, &instance.Status.UserKubeconfig); err != nil {
+				if err := json.Unmarshal(do.Data.RawMessage, &userKubeconfig); err != nil {
 					return fmt.Errorf("unable to unmarshal user kubeconfig: %w", err)
 				}
 			case adminKubeconfigExportName:
-				logger.Info("found export data object for user kubeconfig",
+				logger.Info("found export data object for admin kubeconfig",
 					lc.KeyResource, types.NamespacedName{Name: do.Name, Namespace: do.Namespace}.String())
-				if err := json.Unmarshal(do.Data.RawMessage, &instance.Status.AdminKubeconfig); err != nil {
+				if err := json.Unmarshal(do.Data.RawMessage, &adminKubeconfig); err != nil {
 					return fmt.Errorf("unable to unmarshal admin kubeconfig: %w", err)
 				}
 			case clusterEndpointExportName:
@@ -875,7 +975,7 @@ func (c *Controller) handleExports(ctx context.Context, instance *lssv1alpha1.In
 		}
 	}
 
-	return nil
+	return c.reconcileKubeconfigSecrets(ctx, instance, userKubeconfig, adminKubeconfig)
 }
 
 // handleShootName tries to generate a shoot name if it not already exists.
@@ -26,6 +26,7 @@ import (
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	"github.com/gardener/landscaper-service/pkg/metrics"
 	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
@@ -56,10 +57,20 @@ func (c *Controller) handleDelete(ctx context.Context, instance *lssv1alpha1.Ins
 		return reconcile.Result{}, nil
 	}
 
-	if targetClusterNamespaceDeleted, err = c.ensureDeleteTargetClusterNamespace(ctx, instance); err != nil {
+	if utils.IsOrphaned(instance) {
+		// the service target config has been deleted, therefore the target cluster is not accessible anymore
+		targetClusterNamespaceDeleted = true
+	} else if targetClusterNamespaceDeleted, err = c.ensureDeleteTargetClusterNamespace(ctx, instance, &instance.Spec.ServiceTargetConfigRef); err != nil {
 		return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "DeleteTargetClusterNamespace", err.Error())
 	}
 
+	if targetClusterNamespaceDeleted && instance.IsMigrating() && !utils.IsOrphaned(instance) {
+		// the instance might still be deployed on the source target cluster of an unfinished migration
+		if targetClusterNamespaceDeleted, err = c.ensureDeleteTargetClusterNamespace(ctx, instance, instance.Status.ServiceTargetConfigRef); err != nil {
+			return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "DeleteSourceTargetClusterNamespace", err.Error())
+		}
+	}
+
 	if !targetClusterNamespaceDeleted {
 		// since this namespace is on a different cluster and there is no owner reference set,
 		// the retry has to be triggered manually
@@ -109,25 +120,35 @@ func (c *Controller) handleDelete(ctx context.Context, instance *lssv1alpha1.Ins
 		return reconcile.Result{}, nil
 	}
 
-	serviceTargetConfig := &lssv1alpha1.ServiceTargetConfig{}
-	if err := c.Client().Get(ctx, instance.Spec.ServiceTargetConfigRef.NamespacedName(), serviceTargetConfig); err != nil {
-		return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "GetServiceTargetConfig", err.Error())
+	if instance.IsMigrating() {
+		if err := c.removeInstanceRefFromServiceTargetConfig(ctx, instance, instance.Status.ServiceTargetConfigRef); err != nil {
+			return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "RemoveRefFromSourceServiceTargetConfig", err.Error())
+		}
 	}
 
-	// remove instance reference from service target config
-	serviceTargetConfig.Status.InstanceRefs = utils.RemoveReference(serviceTargetConfig.Status.InstanceRefs, &lssv1alpha1.ObjectReference{
-		Name:      instance.GetName(),
-		Namespace: instance.GetNamespace(),
-	})
+	if !utils.IsOrphaned(instance) {
+		serviceTargetConfig := &lssv1alpha1.ServiceTargetConfig{}
+		if err := c.Client().Get(ctx, instance.Spec.ServiceTargetConfigRef.NamespacedName(), serviceTargetConfig); err != nil {
+			return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "GetServiceTargetConfig", err.Error())
+		}
+
+		// remove instance reference from service target config
+		serviceTargetConfig.Status.InstanceRefs = utils.RemoveReference(serviceTargetConfig.Status.InstanceRefs, &lssv1alpha1.ObjectReference{
+			Name:      instance.GetName(),
+			Namespace: instance.GetNamespace(),
+		})
 
-	if err := c.Client().Status().Update(ctx, serviceTargetConfig); err != nil {
-		return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "RemoveRefFromServiceTargetConfig", err.Error())
+		if err := c.Client().Status().Update(ctx, serviceTargetConfig); err != nil {
+			return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "RemoveRefFromServiceTargetConfig", err.Error())
+		}
 	}
 
 	controllerutil.RemoveFinalizer(instance, lssv1alpha1.LandscaperServiceFinalizer)
 	if err := c.Client().Update(ctx, instance); err != nil {
 		return reconcile.Result{}, lsserrors.NewWrappedError(err, curOp, "RemoveFinalizer", err.Error())
 	}
+	metrics.ObserveDeletionDuration("Instance", instance)
+	c.EventRecorder().Event(instance, corev1.EventTypeNormal, "Deleted", "all resources of the instance have been deleted")
 
 	return reconcile.Result{}, nil
 }
@@ -156,6 +177,8 @@ func (c *Controller) ensureDeleteInstallationForInstance(ctx context.Context, in
 		if err := c.Client().Delete(ctx, installation); err != nil {
 			return false, fmt.Errorf("unable to delete installation for instance: %w", err)
 		}
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "DeletingInstallation",
+			"deleting installation %s", client.ObjectKeyFromObject(installation).String())
 	}
 
 	return false, nil
@@ -185,6 +208,8 @@ func (c *Controller) ensureDeleteTargetForInstance(ctx context.Context, instance
 		if err := c.Client().Delete(ctx, target); err != nil {
 			return false, fmt.Errorf("unable to delete target for instance: %w", err)
 		}
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "DeletingTarget",
+			"deleting target %s", client.ObjectKeyFromObject(target).String())
 	}
 
 	return false, nil
@@ -276,6 +301,8 @@ func (c *Controller) ensureDeleteContextForInstance(ctx context.Context, instanc
 		if err := c.Client().Delete(ctx, landscaperContext); err != nil {
 			return false, fmt.Errorf("unable to delete context for instance: %w", err)
 		}
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "DeletingContext",
+			"deleting context %s", client.ObjectKeyFromObject(landscaperContext).String())
 	}
 
 	return false, nil
@@ -306,8 +333,9 @@ func (c *Controller) deleteSecretsForContext(ctx context.Context, landscaperCont
 	return errors.NewAggregate(errs)
 }
 
-// ensureDeleteTargetClusterNamespace ensures that the target cluster namespace for an instance has been deleted.
-func (c *Controller) ensureDeleteTargetClusterNamespace(ctx context.Context, instance *lssv1alpha1.Instance) (bool, error) {
+// ensureDeleteTargetClusterNamespace ensures that the target cluster namespace for an instance has been deleted
+// on the target cluster of the given service target config.
+func (c *Controller) ensureDeleteTargetClusterNamespace(ctx context.Context, instance *lssv1alpha1.Instance, serviceTargetConfigRef *lssv1alpha1.ObjectReference) (bool, error) {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
 		lc.KeyMethod, "ensureDeleteTargetClusterNamespace")
 
@@ -321,8 +349,8 @@ func (c *Controller) ensureDeleteTargetClusterNamespace(ctx context.Context, ins
 
 	targetClusterClient, err := c.kubeClientExtractor.GetKubeClientFromServiceTargetConfig(
 		ctx,
-		instance.Spec.ServiceTargetConfigRef.Name,
-		instance.Spec.ServiceTargetConfigRef.Namespace,
+		serviceTargetConfigRef.Name,
+		serviceTargetConfigRef.Namespace,
 		c.Client())
 
 	if err != nil {
@@ -345,6 +373,8 @@ func (c *Controller) ensureDeleteTargetClusterNamespace(ctx context.Context, ins
 		if err = targetClusterClient.Delete(ctx, namespace); err != nil {
 			return false, fmt.Errorf("failed to delete target cluster namespace %q: %w", targetClusterNamespace, err)
 		}
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "DeletingTargetClusterNamespace",
+			"deleting namespace %s on the target cluster of service target config %s", targetClusterNamespace, serviceTargetConfigRef.NamespacedName().String())
 		return false, nil
 	}
 
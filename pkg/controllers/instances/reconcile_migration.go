@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+const (
+	// migrationRequeueDuration is the duration after which a migrating instance is reconciled again.
+	// The migration waits for resources on the target clusters, which are not watched by the controller.
+	migrationRequeueDuration = time.Second * 10
+)
+
+// prepareMigration records the start of a migration in the instance status.
+// The migration starts when the service target config reference in the spec differs from the one in the status.
+// It must be called after the target has been switched to the destination target cluster.
+func (c *Controller) prepareMigration(ctx context.Context, instance *lssv1alpha1.Instance) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
+		lc.KeyMethod, "prepareMigration")
+
+	old := instance.DeepCopy()
+
+	if instance.Status.ServiceTargetConfigRef == nil {
+		// the instance is deployed on the service target config of the spec, since the webhook rejects migrations
+		// of instances whose service target config has not been recorded yet
+		instance.Status.ServiceTargetConfigRef = instance.Spec.ServiceTargetConfigRef.DeepCopy()
+	}
+
+	if instance.IsMigrating() {
+		migration := instance.Status.Migration
+		if migration == nil || migration.Phase == lssv1alpha1.InstanceMigrationPhaseSucceeded || !migration.Destination.Equals(&instance.Spec.ServiceTargetConfigRef) {
+			logger.Info("Starting migration", "source", instance.Status.ServiceTargetConfigRef.NamespacedName().String(),
+				"destination", instance.Spec.ServiceTargetConfigRef.NamespacedName().String())
+
+			instance.Status.Migration = &lssv1alpha1.InstanceMigrationStatus{
+				Source:      *instance.Status.ServiceTargetConfigRef,
+				Destination: instance.Spec.ServiceTargetConfigRef,
+				Phase:       lssv1alpha1.InstanceMigrationPhaseInstalling,
+				StartTime:   metav1.Now(),
+				Message:     "installing landscaper on the destination target cluster",
+			}
+
+			if err := c.triggerInstallationReconcile(ctx, instance); err != nil {
+				return err
+			}
+
+			c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "MigrationStarted",
+				"migrating instance from service target config %s to %s",
+				instance.Status.Migration.Source.NamespacedName().String(), instance.Status.Migration.Destination.NamespacedName().String())
+		}
+	}
+
+	return c.updateMigrationStatus(ctx, old, instance)
+}
+
+// triggerInstallationReconcile sets the reconcile annotation at the installation of the instance, so that the
+// landscaper deploys the instance on the target cluster of the updated target, even if the installation spec is unchanged.
+func (c *Controller) triggerInstallationReconcile(ctx context.Context, instance *lssv1alpha1.Instance) error {
+	if instance.Status.InstallationRef == nil || instance.Status.InstallationRef.IsEmpty() {
+		return nil
+	}
+
+	installation := &lsv1alpha1.Installation{}
+	if err := c.Client().Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to get installation: %w", err)
+	}
+
+	if installation.Annotations == nil {
+		installation.Annotations = make(map[string]string)
+	}
+	installation.Annotations[lsv1alpha1.OperationAnnotation] = string(lsv1alpha1.ReconcileOperation)
+
+	if err := c.Client().Update(ctx, installation); err != nil {
+		return fmt.Errorf("unable to set reconcile annotation at installation: %w", err)
+	}
+
+	return nil
+}
+
+// reconcileMigration continues the migration of an instance to another service target config.
+// It is called after the target and the installation have been switched to the destination target cluster.
+// When the installation has succeeded on the destination target cluster, the hosting namespace and the RBAC objects
+// on the source target cluster are removed, and the instance references of both service target configs are updated.
+func (c *Controller) reconcileMigration(ctx context.Context, instance *lssv1alpha1.Instance) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(instance).String()},
+		lc.KeyMethod, "reconcileMigration")
+
+	if !instance.IsMigrating() || instance.Status.Migration == nil {
+		return nil
+	}
+
+	old := instance.DeepCopy()
+	migration := instance.Status.Migration
+
+	installation := &lsv1alpha1.Installation{}
+	if err := c.Client().Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation); err != nil {
+		return fmt.Errorf("unable to get installation: %w", err)
+	}
+
+	if !isInstallationSucceeded(installation) {
+		migration.Phase = lssv1alpha1.InstanceMigrationPhaseInstalling
+		migration.Message = fmt.Sprintf("waiting for the installation on the destination target cluster, installation phase: %q",
+			installation.Status.InstallationPhase)
+		return c.updateMigrationStatus(ctx, old, instance)
+	}
+
+	if migration.Phase != lssv1alpha1.InstanceMigrationPhaseCleaningUp {
+		c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "MigrationCleaningUp",
+			"removing landscaper from the source target cluster of service target config %s", migration.Source.NamespacedName().String())
+	}
+
+	migration.Phase = lssv1alpha1.InstanceMigrationPhaseCleaningUp
+	migration.Message = "removing landscaper from the source target cluster"
+	if err := c.updateMigrationStatus(ctx, old, instance); err != nil {
+		return err
+	}
+
+	namespaceDeleted, err := c.ensureDeleteTargetClusterNamespace(ctx, instance, &migration.Source)
+	if err != nil {
+		return fmt.Errorf("unable to clean up source target cluster: %w", err)
+	}
+
+	if !namespaceDeleted {
+		logger.Info("Waiting for the deletion of the target cluster namespace on the source target cluster")
+		return nil
+	}
+
+	if err := c.removeInstanceRefFromServiceTargetConfig(ctx, instance, &migration.Source); err != nil {
+		return err
+	}
+
+	if err := c.addInstanceRefToServiceTargetConfig(ctx, instance, &instance.Spec.ServiceTargetConfigRef); err != nil {
+		return err
+	}
+
+	old = instance.DeepCopy()
+	now := metav1.Now()
+	instance.Status.ServiceTargetConfigRef = instance.Spec.ServiceTargetConfigRef.DeepCopy()
+	migration.Phase = lssv1alpha1.InstanceMigrationPhaseSucceeded
+	migration.CompletionTime = &now
+	migration.Message = "migration has finished"
+	if err := c.updateMigrationStatus(ctx, old, instance); err != nil {
+		return err
+	}
+
+	logger.Info("Migration has finished", "destination", instance.Spec.ServiceTargetConfigRef.NamespacedName().String())
+	c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "MigrationSucceeded",
+		"instance has been migrated to service target config %s", instance.Spec.ServiceTargetConfigRef.NamespacedName().String())
+
+	if utils.HasOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationMigrate) {
+		utils.RemoveOperationAnnotation(instance)
+		if err := c.Client().Update(ctx, instance); err != nil {
+			return fmt.Errorf("unable to remove migrate operation annotation: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) updateMigrationStatus(ctx context.Context, old, instance *lssv1alpha1.Instance) error {
+	if !reflect.DeepEqual(old.Status, instance.Status) {
+		if err := c.Client().Status().Update(ctx, instance); err != nil {
+			return fmt.Errorf("unable to update migration status: %w", err)
+		}
+	}
+	return nil
+}
+
+// addInstanceRefToServiceTargetConfig adds the reference of the instance to the status of a service target config.
+func (c *Controller) addInstanceRefToServiceTargetConfig(ctx context.Context, instance *lssv1alpha1.Instance, configRef *lssv1alpha1.ObjectReference) error {
+	serviceTargetConfig := &lssv1alpha1.ServiceTargetConfig{}
+	if err := c.Client().Get(ctx, configRef.NamespacedName(), serviceTargetConfig); err != nil {
+		return fmt.Errorf("unable to get service target config %q: %w", configRef.NamespacedName().String(), err)
+	}
+
+	instanceRef := &lssv1alpha1.ObjectReference{
+		Name:      instance.GetName(),
+		Namespace: instance.GetNamespace(),
+	}
+	if utils.ContainsReference(serviceTargetConfig.Status.InstanceRefs, instanceRef) {
+		return nil
+	}
+
+	serviceTargetConfig.Status.InstanceRefs = append(serviceTargetConfig.Status.InstanceRefs, *instanceRef)
+	if err := c.Client().Status().Update(ctx, serviceTargetConfig); err != nil {
+		return fmt.Errorf("unable to add instance reference to service target config %q: %w", configRef.NamespacedName().String(), err)
+	}
+
+	return nil
+}
+
+// removeInstanceRefFromServiceTargetConfig removes the reference of the instance from the status of a service target config.
+// A service target config that does not exist anymore is ignored.
+func (c *Controller) removeInstanceRefFromServiceTargetConfig(ctx context.Context, instance *lssv1alpha1.Instance, configRef *lssv1alpha1.ObjectReference) error {
+	serviceTargetConfig := &lssv1alpha1.ServiceTargetConfig{}
+	if err := c.Client().Get(ctx, configRef.NamespacedName(), serviceTargetConfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to get service target config %q: %w", configRef.NamespacedName().String(), err)
+	}
+
+	instanceRef := &lssv1alpha1.ObjectReference{
+		Name:      instance.GetName(),
+		Namespace: instance.GetNamespace(),
+	}
+	if !utils.ContainsReference(serviceTargetConfig.Status.InstanceRefs, instanceRef) {
+		return nil
+	}
+
+	serviceTargetConfig.Status.InstanceRefs = utils.RemoveReference(serviceTargetConfig.Status.InstanceRefs, instanceRef)
+	if err := c.Client().Status().Update(ctx, serviceTargetConfig); err != nil {
+		return fmt.Errorf("unable to remove instance reference from service target config %q: %w", configRef.NamespacedName().String(), err)
+	}
+
+	return nil
+}
+
+// isInstallationSucceeded returns true if the last reconciliation of the installation has finished successfully.
+func isInstallationSucceeded(installation *lsv1alpha1.Installation) bool {
+	if _, ok := installation.Annotations[lsv1alpha1.OperationAnnotation]; ok {
+		// the reconciliation has not yet been picked up by the landscaper
+		return false
+	}
+
+	return installation.Status.JobID == installation.Status.JobIDFinished &&
+		installation.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Succeeded
+}
@@ -6,6 +6,7 @@ package instances_test
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -17,9 +18,12 @@ import (
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
@@ -165,6 +169,7 @@ var _ = Describe("Reconcile", func() {
 		Expect(testenv.Client.Create(ctx, endpointExport)).To(Succeed())
 
 		userKubeConfig := "userkubeconfigdata"
+		encodedUserKubeConfig := base64.StdEncoding.EncodeToString([]byte(userKubeConfig))
 		userKubeconfigExport := &lsv1alpha1.DataObject{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "userkubeconfigexport",
@@ -175,11 +180,12 @@ var _ = Describe("Reconcile", func() {
 					lsv1alpha1.DataObjectSourceTypeLabel: string(lsv1alpha1.ExportDataObjectSourceType),
 				},
 			},
-			Data: utils.StringToAnyJSON(userKubeConfig),
+			Data: utils.StringToAnyJSON(encodedUserKubeConfig),
 		}
 		Expect(testenv.Client.Create(ctx, userKubeconfigExport)).To(Succeed())
 
 		adminKubeConfig := "adminkubeconfigdata"
+		encodedAdminKubeConfig := base64.StdEncoding.EncodeToString([]byte(adminKubeConfig))
 		adminKubeconfigExport := &lsv1alpha1.DataObject{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "adminkubeconfigexport",
@@ -190,7 +196,7 @@ var _ = Describe("Reconcile", func() {
 					lsv1alpha1.DataObjectSourceTypeLabel: string(lsv1alpha1.ExportDataObjectSourceType),
 				},
 			},
-			Data: utils.StringToAnyJSON(adminKubeConfig),
+			Data: utils.StringToAnyJSON(encodedAdminKubeConfig),
 		}
 		Expect(testenv.Client.Create(ctx, adminKubeconfigExport)).To(Succeed())
 
@@ -198,8 +204,33 @@ var _ = Describe("Reconcile", func() {
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
 
 		Expect(instance.Status.ClusterEndpoint).To(Equal(clusterEndpoint))
-		Expect(instance.Status.UserKubeconfig).To(Equal(userKubeConfig))
-		Expect(instance.Status.AdminKubeconfig).To(Equal(adminKubeConfig))
+		Expect(instance.Status.UserKubeconfig).To(BeEmpty())
+		Expect(instance.Status.AdminKubeconfig).To(BeEmpty())
+
+		Expect(instance.Status.UserKubeconfigSecretRef).ToNot(BeNil())
+		userKubeconfigSecret := &corev1.Secret{}
+		Expect(testenv.Client.Get(ctx, instance.Status.UserKubeconfigSecretRef.NamespacedName(), userKubeconfigSecret)).To(Succeed())
+		Expect(userKubeconfigSecret.Name).To(Equal(fmt.Sprintf("%s-user-kubeconfig", instance.Name)))
+		Expect(userKubeconfigSecret.Data).To(HaveKeyWithValue(instance.Status.UserKubeconfigSecretRef.Key, []byte(userKubeConfig)))
+		Expect(metav1.IsControlledBy(userKubeconfigSecret, instance)).To(BeTrue())
+
+		Expect(instance.Status.AdminKubeconfigSecretRef).ToNot(BeNil())
+		adminKubeconfigSecret := &corev1.Secret{}
+		Expect(testenv.Client.Get(ctx, instance.Status.AdminKubeconfigSecretRef.NamespacedName(), adminKubeconfigSecret)).To(Succeed())
+		Expect(adminKubeconfigSecret.Name).To(Equal(fmt.Sprintf("%s-admin-kubeconfig", instance.Name)))
+		Expect(adminKubeconfigSecret.Data).To(HaveKeyWithValue(instance.Status.AdminKubeconfigSecretRef.Key, []byte(adminKubeConfig)))
+		Expect(metav1.IsControlledBy(adminKubeconfigSecret, instance)).To(BeTrue())
+
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.InstanceConditionContextReady)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.InstanceConditionTargetReady)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.InstanceConditionShootReady)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.InstanceConditionKubeconfigExported)).To(BeTrue())
+		installationSucceeded := meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.InstanceConditionInstallationSucceeded)
+		Expect(installationSucceeded).ToNot(BeNil())
+		Expect(installationSucceeded.Status).To(Equal(metav1.ConditionUnknown))
+		ready := meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.InstanceConditionReady)
+		Expect(ready).ToNot(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionUnknown))
 	})
 
 	It("should create a context, target and an installation (external data plane)", func() {
@@ -508,6 +539,193 @@ var _ = Describe("Reconcile", func() {
 		Expect(auditLogService).To(HaveKeyWithValue("password", op.Config().AuditLogConfig.AuditLogService.Password))
 	})
 
+	It("should create the shoot cluster in the region of the service target config", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		op.Config().ShootConfiguration.Region = "eu-west-1"
+		op.Config().ShootConfiguration.Provider = lssconfig.ShootProviderConfiguration{Type: "aws", Zone: "eu-west-1a"}
+		op.Config().ShootConfiguration.Workers.Machine.Type = "m5.large"
+		op.Config().ShootRegionProfiles = []lssconfig.ShootRegionProfile{
+			{
+				Region:   "us-central1",
+				Provider: lssconfig.ShootProviderConfiguration{Type: "gcp", Zone: "us-central1-a"},
+				Machine:  &lssconfig.ShootMachineConfiguration{Type: "n2-standard-2"},
+			},
+		}
+
+		config := state.GetConfig("default")
+		config.Spec.Region = "us-central1"
+		config.Spec.Provider = "gcp"
+		Expect(testenv.Client.Update(ctx, config)).To(Succeed())
+
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.ShootRegion).To(Equal("us-central1"))
+		Expect(instance.Status.ShootProvider).To(Equal("gcp"))
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+
+		shootConfig := &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Region).To(Equal("us-central1"))
+		Expect(shootConfig.Provider.Type).To(Equal("gcp"))
+		Expect(shootConfig.Provider.Zone).To(Equal("us-central1-a"))
+		Expect(shootConfig.Workers.Machine.Type).To(Equal("n2-standard-2"))
+
+		// the region of the shoot cluster is kept when the region of the service target config is changed
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		config.Spec.Region = "eu-west-1"
+		config.Spec.Provider = "aws"
+		Expect(testenv.Client.Update(ctx, config)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.ShootRegion).To(Equal("us-central1"))
+	})
+
+	It("should fail if no shoot region profile is configured for the requested region", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		op.Config().ShootConfiguration.Region = "eu-west-1"
+
+		instance := state.GetInstance("test")
+		instance.Spec.Region = "ap-south-1"
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.ShootRegion).To(BeEmpty())
+		Expect(instance.Status.InstallationRef).To(BeNil())
+		Expect(instance.Status.LastError).ToNot(BeNil())
+		Expect(instance.Status.LastError.Message).To(ContainSubstring("no shoot region profile configured for region \"ap-south-1\""))
+	})
+
+	It("should apply the selected shoot profile", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		op.Config().ShootConfiguration.Workers.Machine.Type = "m5.large"
+		op.Config().ShootConfiguration.Workers.Minimum = ptr.To[int32](1)
+		op.Config().ShootConfiguration.Workers.Maximum = ptr.To[int32](3)
+		op.Config().ShootConfiguration.Kubernetes.Version = "1.28.9"
+		op.Config().ShootProfiles = []lssconfig.ShootProfile{
+			{
+				Name:              "large",
+				MachineType:       "m5.2xlarge",
+				Maximum:           ptr.To[int32](6),
+				KubernetesVersion: "1.29.4",
+			},
+		}
+
+		instance := state.GetInstance("test")
+		instance.Spec.ShootProfile = "large"
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.ShootProfile).To(Equal("large"))
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+
+		shootConfig := &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Workers.Machine.Type).To(Equal("m5.2xlarge"))
+		Expect(shootConfig.Workers.Minimum).To(Equal(ptr.To[int32](1)))
+		Expect(shootConfig.Workers.Maximum).To(Equal(ptr.To[int32](6)))
+		Expect(shootConfig.Kubernetes.Version).To(Equal("1.29.4"))
+
+		// the shoot configuration of the landscaper service is not modified
+		Expect(op.Config().ShootConfiguration.Workers.Machine.Type).To(Equal("m5.large"))
+		Expect(op.Config().ShootConfiguration.Kubernetes.Version).To(Equal("1.28.9"))
+	})
+
+	It("should enable the sidecar webhooks server", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		op.Config().SidecarWebhooksServer = &lssconfig.SidecarWebhooksServerConfiguration{
+			URL:                   "https://sidecar-webhooks.example.com",
+			CertificatesNamespace: "ls-system",
+		}
+
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+
+		sidecarConfig := map[string]interface{}{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.SidecarConfigImportName].RawMessage, &sidecarConfig)).To(Succeed())
+		Expect(sidecarConfig).To(HaveKeyWithValue("webhooksServer", map[string]interface{}{
+			"url":                   "https://sidecar-webhooks.example.com",
+			"certificatesNamespace": "ls-system",
+		}))
+	})
+
+	It("should apply the default shoot configuration if the selected shoot profile is not configured", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		op.Config().ShootConfiguration.Workers.Machine.Type = "m5.large"
+		op.Config().ShootConfiguration.Kubernetes.Version = "1.28.9"
+
+		instance := state.GetInstance("test")
+		instance.Spec.ShootProfile = "large"
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.InstallationRef).ToNot(BeNil())
+		Expect(instance.Status.ShootProfile).To(BeEmpty())
+		Expect(instance.Status.ShootKubernetesVersion).To(Equal("1.28.9"))
+
+		condition := meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.InstanceConditionShootProfileAvailable)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("ShootProfileNotConfigured"))
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+
+		shootConfig := &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Workers.Machine.Type).To(Equal("m5.large"))
+
+		// the kubernetes version of the shoot cluster is not lowered
+		op.Config().ShootConfiguration.Kubernetes.Version = "1.27.1"
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(installation), installation)).To(Succeed())
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Kubernetes.Version).To(Equal("1.28.9"))
+	})
+
 	It("should handle the automatic reconcile with explicit duration set", func() {
 		var err error
 		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test6")
@@ -568,5 +786,444 @@ var _ = Describe("Reconcile", func() {
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
 
 		Expect(instance.Status.Phase).To(Equal(lsv1alpha1.PhaseStringSucceeded))
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.InstanceConditionInstallationSucceeded)).To(BeTrue())
+
+		installation.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Failed
+		Expect(testenv.Client.Status().Update(ctx, installation)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(meta.IsStatusConditionFalse(instance.Status.Conditions, lssv1alpha1.InstanceConditionInstallationSucceeded)).To(BeTrue())
+		Expect(meta.IsStatusConditionFalse(instance.Status.Conditions, lssv1alpha1.InstanceConditionReady)).To(BeTrue())
+	})
+
+	It("should record events for the created resources", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder := record.NewFakeRecorder(100)
+		ctrl.SetEventRecorder(recorder)
+
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+
+		events := make([]string, 0)
+		for len(recorder.Events) > 0 {
+			events = append(events, <-recorder.Events)
+		}
+		Expect(events).To(ContainElement(HavePrefix("Normal ContextCreated")))
+		Expect(events).To(ContainElement(HavePrefix("Normal TargetCreated")))
+		Expect(events).To(ContainElement(HavePrefix("Normal InstallationCreated")))
+		Expect(events).To(ContainElement(HavePrefix("Normal ContextReconciled")))
+	})
+
+	It("should migrate an instance to another service target config", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test8")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+		source := state.GetConfig("source")
+		destination := state.GetConfig("destination")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.ServiceTargetConfigRef).ToNot(BeNil())
+		Expect(instance.Status.ServiceTargetConfigRef.Name).To(Equal(source.Name))
+		Expect(instance.Status.Migration).To(BeNil())
+		Expect(instance.Status.InstallationRef).ToNot(BeNil())
+
+		source.Status.InstanceRefs = []lssv1alpha1.ObjectReference{{Name: instance.Name, Namespace: instance.Namespace}}
+		Expect(testenv.Client.Status().Update(ctx, source)).To(Succeed())
+
+		instance.Spec.ServiceTargetConfigRef.Name = destination.Name
+		metav1.SetMetaDataAnnotation(&instance.ObjectMeta, lssv1alpha1.LandscaperServiceOperationAnnotation, lssv1alpha1.LandscaperServiceOperationMigrate)
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.IsMigrating()).To(BeTrue())
+		Expect(instance.Status.Migration).ToNot(BeNil())
+		Expect(instance.Status.Migration.Phase).To(Equal(lssv1alpha1.InstanceMigrationPhaseInstalling))
+		Expect(instance.Status.Migration.Source.Name).To(Equal(source.Name))
+		Expect(instance.Status.Migration.Destination.Name).To(Equal(destination.Name))
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ReconcileOperation)))
+
+		delete(installation.Annotations, lsv1alpha1.OperationAnnotation)
+		Expect(testenv.Client.Update(ctx, installation)).To(Succeed())
+		installation.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		installation.Status.JobID = "job"
+		installation.Status.JobIDFinished = "job"
+		Expect(testenv.Client.Status().Update(ctx, installation)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.IsMigrating()).To(BeFalse())
+		Expect(instance.Status.ServiceTargetConfigRef.Name).To(Equal(destination.Name))
+		Expect(instance.Status.Migration.Phase).To(Equal(lssv1alpha1.InstanceMigrationPhaseSucceeded))
+		Expect(instance.Status.Migration.CompletionTime).ToNot(BeNil())
+		Expect(instance.Annotations).ToNot(HaveKey(lssv1alpha1.LandscaperServiceOperationAnnotation))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(source), source)).To(Succeed())
+		Expect(source.Status.InstanceRefs).To(BeEmpty())
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(destination), destination)).To(Succeed())
+		Expect(destination.Status.InstanceRefs).To(ContainElement(lssv1alpha1.ObjectReference{Name: instance.Name, Namespace: instance.Namespace}))
+	})
+
+	It("should not reconcile an orphaned instance", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		metav1.SetMetaDataAnnotation(&instance.ObjectMeta, lssv1alpha1.LandscaperServiceOrphanedAnnotation, "deleted/default")
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.InstallationRef).To(BeNil())
+		Expect(instance.Status.LastError).ToNot(BeNil())
+		Expect(instance.Status.LastError.Reason).To(Equal("Orphaned"))
+
+		Expect(testenv.Client.Delete(ctx, instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.WaitForObjectToBeDeleted(ctx, testenv.Client, instance, 5*time.Second)).To(Succeed())
+	})
+
+	It("should install the selected version of the landscaper service component", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		deprecationDate := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+		op.Config().LandscaperServiceComponent.SupportedVersions = []lssconfig.SupportedVersion{
+			{Version: "v1.0.3", DeprecationDate: &deprecationDate},
+			{Version: op.Config().LandscaperServiceComponent.Version},
+		}
+
+		instance := state.GetInstance("test")
+		instance.Spec.Version = "v1.0.3"
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		recorder := record.NewFakeRecorder(100)
+		ctrl.SetEventRecorder(recorder)
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Spec.ComponentDescriptor.Reference.Version).To(Equal("v1.0.3"))
+
+		Expect(instance.Status.LandscaperServiceComponent).ToNot(BeNil())
+		Expect(instance.Status.LandscaperServiceComponent.Version).To(Equal("v1.0.3"))
+		Expect(instance.Status.LandscaperServiceComponent.DeprecationDate).ToNot(BeNil())
+		Expect(instance.Status.LandscaperServiceComponent.DeprecationDate.Time).To(BeTemporally("==", deprecationDate.Time))
+
+		deprecated := meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.InstanceConditionVersionSupported)
+		Expect(deprecated).ToNot(BeNil())
+		Expect(deprecated.Status).To(Equal(metav1.ConditionFalse))
+		Expect(deprecated.Reason).To(Equal("VersionDeprecated"))
+		Eventually(recorder.Events).Should(Receive(ContainSubstring("VersionDeprecated")))
+
+		// the event is not recorded again if the deprecation has not changed
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Consistently(recorder.Events).ShouldNot(Receive(ContainSubstring("VersionDeprecated")))
+	})
+
+	It("should not install an unsupported version of the landscaper service component", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+		instance.Spec.Version = "v0.9.0"
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.InstallationRef).To(BeNil())
+		Expect(instance.Status.LastError).ToNot(BeNil())
+		Expect(instance.Status.LastError.Reason).To(Equal("UnsupportedVersion"))
+	})
+
+	It("should keep the installed version until the upgrade has been approved", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		installedVersion := op.Config().LandscaperServiceComponent.Version
+		Expect(instance.Status.LandscaperServiceComponent.Version).To(Equal(installedVersion))
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.InstanceConditionVersionUpToDate)).To(BeTrue())
+
+		op.Config().LandscaperServiceComponent.Version = "v1.2.0"
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Spec.ComponentDescriptor.Reference.Version).To(Equal(installedVersion))
+		Expect(instance.Status.LandscaperServiceComponent.Version).To(Equal(installedVersion))
+
+		upToDate := meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.InstanceConditionVersionUpToDate)
+		Expect(upToDate).ToNot(BeNil())
+		Expect(upToDate.Status).To(Equal(metav1.ConditionFalse))
+		Expect(upToDate.Reason).To(Equal("UpgradePending"))
+
+		metav1.SetMetaDataAnnotation(&instance.ObjectMeta, lssv1alpha1.LandscaperServiceUpgradeApprovedAnnotation, "v1.2.0")
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Spec.ComponentDescriptor.Reference.Version).To(Equal("v1.2.0"))
+		Expect(instance.Status.LandscaperServiceComponent.Version).To(Equal("v1.2.0"))
+		Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.InstanceConditionVersionUpToDate)).To(BeTrue())
+	})
+
+	It("should migrate the kubeconfigs from the status into secrets", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+		instance.Spec.UserKubeconfigSecretName = "my-kubeconfig"
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		userKubeConfig := "userkubeconfigdata"
+		adminKubeConfig := "adminkubeconfigdata"
+		instance.Status.UserKubeconfig = base64.StdEncoding.EncodeToString([]byte(userKubeConfig))
+		instance.Status.AdminKubeconfig = base64.StdEncoding.EncodeToString([]byte(adminKubeConfig))
+		Expect(testenv.Client.Status().Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.UserKubeconfig).To(BeEmpty())
+		Expect(instance.Status.AdminKubeconfig).To(BeEmpty())
+
+		Expect(instance.Status.UserKubeconfigSecretRef).ToNot(BeNil())
+		Expect(instance.Status.UserKubeconfigSecretRef.Name).To(Equal("my-kubeconfig"))
+		userKubeconfigSecret := &corev1.Secret{}
+		Expect(testenv.Client.Get(ctx, instance.Status.UserKubeconfigSecretRef.NamespacedName(), userKubeconfigSecret)).To(Succeed())
+		Expect(userKubeconfigSecret.Data).To(HaveKeyWithValue(instance.Status.UserKubeconfigSecretRef.Key, []byte(userKubeConfig)))
+
+		Expect(instance.Status.AdminKubeconfigSecretRef).ToNot(BeNil())
+		adminKubeconfigSecret := &corev1.Secret{}
+		Expect(testenv.Client.Get(ctx, instance.Status.AdminKubeconfigSecretRef.NamespacedName(), adminKubeconfigSecret)).To(Succeed())
+		Expect(adminKubeconfigSecret.Data).To(HaveKeyWithValue(instance.Status.AdminKubeconfigSecretRef.Key, []byte(adminKubeConfig)))
+
+		instance.Spec.UserKubeconfigSecretName = "my-other-kubeconfig"
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.UserKubeconfigSecretRef.Name).To(Equal("my-other-kubeconfig"))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(userKubeconfigSecret), userKubeconfigSecret)).ToNot(Succeed())
+	})
+
+	It("should rotate the credentials on demand", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.CredentialsRotation).To(BeNil())
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		rotationConfig := &lsinstallation.RotationConfig{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.RotationConfigImportName].RawMessage, rotationConfig)).To(Succeed())
+		Expect(rotationConfig.TokenExpirationSeconds).To(Equal(int64(op.Config().CredentialRotation.TokenExpiration.Seconds())))
+		Expect(rotationConfig.AdminKubeconfigExpirationSeconds).To(Equal(int64(op.Config().CredentialRotation.AdminKubeconfigExpiration.Seconds())))
+		Expect(rotationConfig.RotationTimestamp).To(BeEmpty())
+		Expect(rotationConfig.ServiceAccountSuffix).To(BeEmpty())
+
+		delete(installation.Annotations, lsv1alpha1.OperationAnnotation)
+		Expect(testenv.Client.Update(ctx, installation)).To(Succeed())
+		installation.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		Expect(testenv.Client.Status().Update(ctx, installation)).To(Succeed())
+
+		utils.SetOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationRotateCredentials)
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(utils.HasOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationRotateCredentials)).To(BeFalse())
+		Expect(instance.Status.CredentialsRotation).ToNot(BeNil())
+		Expect(instance.Status.CredentialsRotation.LastCompletionTime).To(BeNil())
+		Expect(instance.IsRotatingCredentials()).To(BeTrue())
+
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ReconcileOperation)))
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.RotationConfigImportName].RawMessage, rotationConfig)).To(Succeed())
+		Expect(rotationConfig.RotationTimestamp).To(Equal(instance.Status.CredentialsRotation.LastInitiationTime.UTC().Format(time.RFC3339)))
+		// the service accounts are recreated with a new name, which invalidates the tokens issued before
+		Expect(rotationConfig.ServiceAccountSuffix).To(MatchRegexp("^-[0-9a-f]{8}$"))
+		firstSuffix := rotationConfig.ServiceAccountSuffix
+
+		delete(installation.Annotations, lsv1alpha1.OperationAnnotation)
+		Expect(testenv.Client.Update(ctx, installation)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(instance.Status.CredentialsRotation.LastCompletionTime).ToNot(BeNil())
+		Expect(instance.IsRotatingCredentials()).To(BeFalse())
+
+		// a further rotation changes the service accounts again
+		time.Sleep(time.Second)
+		utils.SetOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationRotateCredentials)
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		rotationConfig = &lsinstallation.RotationConfig{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.RotationConfigImportName].RawMessage, rotationConfig)).To(Succeed())
+		Expect(rotationConfig.ServiceAccountSuffix).To(MatchRegexp("^-[0-9a-f]{8}$"))
+		Expect(rotationConfig.ServiceAccountSuffix).ToNot(Equal(firstSuffix))
+	})
+
+	It("should hibernate and wake up an instance", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("test")
+		instance.Spec.Hibernation = &lssv1alpha1.Hibernation{
+			Enabled: ptr.To(true),
+		}
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.Hibernated).To(BeTrue())
+
+		installation := &lsv1alpha1.Installation{}
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+
+		landscaperConfig := &lsinstallation.LandscaperConfig{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.LandscaperConfigImportName].RawMessage, landscaperConfig)).To(Succeed())
+		Expect(landscaperConfig.Hibernated).To(BeTrue())
+
+		sidecarConfig := &lsinstallation.SidecarConfig{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.SidecarConfigImportName].RawMessage, sidecarConfig)).To(Succeed())
+		Expect(sidecarConfig.Hibernated).To(BeTrue())
+
+		// the shoot cluster is not hibernated, before the landscaper has been scaled down
+		shootConfig := &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Hibernation).ToNot(BeNil())
+		Expect(shootConfig.Hibernation.Enabled).To(BeFalse())
+
+		delete(installation.Annotations, lsv1alpha1.OperationAnnotation)
+		Expect(testenv.Client.Update(ctx, installation)).To(Succeed())
+		installation.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		Expect(testenv.Client.Status().Update(ctx, installation)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		Expect(installation.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ReconcileOperation)))
+		shootConfig = &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Hibernation).ToNot(BeNil())
+		Expect(shootConfig.Hibernation.Enabled).To(BeTrue())
+
+		// the shoot cluster stays hibernated, while the installation is reconciled
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		shootConfig = &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Hibernation.Enabled).To(BeTrue())
+
+		instance.Spec.Hibernation = &lssv1alpha1.Hibernation{
+			Schedules: []lssv1alpha1.HibernationSchedule{
+				{Start: "0 20 * * *", End: "0 6 * * *", Location: "Europe/Berlin"},
+			},
+		}
+		now := time.Now()
+		hibernated, err := utils.IsHibernated(instance.Spec.Hibernation, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		result := testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(result.RequeueAfter).To(BeNumerically("<=", 24*time.Hour))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.Hibernated).To(Equal(hibernated))
+
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		shootConfig = &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		Expect(shootConfig.Hibernation).ToNot(BeNil())
+		Expect(shootConfig.Hibernation.Enabled).To(Equal(hibernated))
+
+		instance.Spec.Hibernation = nil
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Status.Hibernated).To(BeFalse())
+
+		Expect(testenv.Client.Get(ctx, instance.Status.InstallationRef.NamespacedName(), installation)).To(Succeed())
+		landscaperConfig = &lsinstallation.LandscaperConfig{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.LandscaperConfigImportName].RawMessage, landscaperConfig)).To(Succeed())
+		Expect(landscaperConfig.Hibernated).To(BeFalse())
+
+		// the shoot cluster is woken up together with the landscaper
+		shootConfig = &lssconfig.ShootConfiguration{}
+		Expect(json.Unmarshal(installation.Spec.ImportDataMappings[lsinstallation.ShootConfigImportName].RawMessage, shootConfig)).To(Succeed())
+		if hibernated {
+			Expect(shootConfig.Hibernation).ToNot(BeNil())
+			Expect(shootConfig.Hibernation.Enabled).To(BeFalse())
+		} else {
+			Expect(shootConfig.Hibernation).To(BeNil())
+		}
 	})
 })
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/utils/ptr"
+
+	lssconfig "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// handleShootRegion determines the region and the provider type of the shoot cluster of an instance, if they are not yet recorded in the instance status.
+// The region is the region requested by the instance, otherwise the region of its service target config, otherwise the region of the shoot configuration.
+// Instances whose installation has been created before the region was recorded keep the region of the shoot configuration.
+func (c *Controller) handleShootRegion(ctx context.Context, instance *lssv1alpha1.Instance) error {
+	if len(instance.Status.ShootRegion) > 0 {
+		return nil
+	}
+
+	shootConfig := &c.Config().ShootConfiguration
+	region := shootConfig.Region
+	providerType := shootConfig.Provider.Type
+
+	if instance.Status.InstallationRef == nil || instance.Status.InstallationRef.IsEmpty() {
+		config := &lssv1alpha1.ServiceTargetConfig{}
+		if err := c.Client().Get(ctx, instance.Spec.ServiceTargetConfigRef.NamespacedName(), config); err != nil {
+			return fmt.Errorf("unable to get service target config for instance: %w", err)
+		}
+
+		if len(instance.Spec.Region) > 0 {
+			region = instance.Spec.Region
+		} else if len(config.Spec.Region) > 0 {
+			region = config.Spec.Region
+		}
+		providerType = config.Spec.Provider
+	}
+
+	profile, err := c.getShootRegionProfile(region, providerType)
+	if err != nil {
+		return err
+	}
+
+	instance.Status.ShootRegion = region
+	if profile != nil {
+		instance.Status.ShootProvider = profile.Provider.Type
+	} else {
+		instance.Status.ShootProvider = shootConfig.Provider.Type
+	}
+
+	return nil
+}
+
+// getShootRegionProfile returns the shoot region profile for the given region and provider type.
+// If the provider type is empty, the profile is selected by the region only.
+// Nil is returned for the region and provider type of the shoot configuration, if there is no dedicated profile.
+func (c *Controller) getShootRegionProfile(region, providerType string) (*lssconfig.ShootRegionProfile, error) {
+	profiles := c.Config().GetShootRegionProfiles(region, providerType)
+	if len(profiles) == 1 {
+		return &profiles[0], nil
+	}
+	if len(profiles) > 1 {
+		return nil, fmt.Errorf("there is more than one shoot region profile for region %q, the provider of the service target config must be specified", region)
+	}
+
+	shootConfig := &c.Config().ShootConfiguration
+	if region == shootConfig.Region && (len(providerType) == 0 || providerType == shootConfig.Provider.Type) {
+		return nil, nil
+	}
+
+	if len(providerType) > 0 {
+		return nil, fmt.Errorf("no shoot region profile configured for region %q and provider type %q", region, providerType)
+	}
+	return nil, fmt.Errorf("no shoot region profile configured for region %q", region)
+}
+
+// newShootConfiguration creates the shoot configuration of an instance.
+// The region specific parts of the shoot configuration are replaced by the shoot region profile of the region of the shoot cluster.
+// Afterwards, the shoot profile selected by the instance is applied, unless it has been removed from the landscaper service.
+// The kubernetes version of the shoot cluster is never lowered, because gardener rejects the downgrade of a shoot cluster.
+func (c *Controller) newShootConfiguration(instance *lssv1alpha1.Instance) (*lssconfig.ShootConfiguration, error) {
+	shootConfig := &lssconfig.ShootConfiguration{}
+	c.Config().ShootConfiguration.DeepCopyInto(shootConfig)
+
+	if len(instance.Status.ShootRegion) > 0 {
+		profile, err := c.getShootRegionProfile(instance.Status.ShootRegion, instance.Status.ShootProvider)
+		if err != nil {
+			return nil, err
+		}
+		if profile != nil {
+			applyShootRegionProfile(shootConfig, profile)
+		}
+	}
+
+	if profile := c.Config().GetShootProfile(instance.Spec.ShootProfile); profile != nil {
+		applyShootProfile(shootConfig, profile)
+	}
+
+	if isLowerKubernetesVersion(shootConfig.Kubernetes.Version, instance.Status.ShootKubernetesVersion) {
+		shootConfig.Kubernetes.Version = instance.Status.ShootKubernetesVersion
+	}
+
+	return shootConfig, nil
+}
+
+// getAppliedShootProfile returns the name of the shoot profile which is applied to the shoot cluster of an instance.
+// It is empty if the instance doesn't select a shoot profile, or if the selected shoot profile is not configured.
+func (c *Controller) getAppliedShootProfile(instance *lssv1alpha1.Instance) string {
+	if c.Config().GetShootProfile(instance.Spec.ShootProfile) == nil {
+		return ""
+	}
+	return instance.Spec.ShootProfile
+}
+
+// setShootProfileCondition sets the ShootProfileAvailable condition for instances which select a shoot profile.
+// If the shoot profile has been removed from the landscaper service, the default shoot configuration is applied instead.
+func (c *Controller) setShootProfileCondition(instance *lssv1alpha1.Instance) {
+	if len(instance.Spec.ShootProfile) == 0 {
+		meta.RemoveStatusCondition(&instance.Status.Conditions, lssv1alpha1.InstanceConditionShootProfileAvailable)
+		return
+	}
+
+	if c.Config().GetShootProfile(instance.Spec.ShootProfile) == nil {
+		setCondition(instance, lssv1alpha1.InstanceConditionShootProfileAvailable, metav1.ConditionFalse, "ShootProfileNotConfigured",
+			fmt.Sprintf("shoot profile %q is not configured, the default shoot configuration is applied", instance.Spec.ShootProfile))
+	} else {
+		setCondition(instance, lssv1alpha1.InstanceConditionShootProfileAvailable, metav1.ConditionTrue, "ShootProfileAvailable",
+			fmt.Sprintf("shoot profile %q is applied", instance.Spec.ShootProfile))
+	}
+}
+
+// isLowerKubernetesVersion returns whether the kubernetes version is lower than the other one.
+// Returns false if one of the versions is empty or invalid.
+func isLowerKubernetesVersion(version, other string) bool {
+	if len(version) == 0 || len(other) == 0 {
+		return false
+	}
+	v, err := utilversion.ParseGeneric(version)
+	if err != nil {
+		return false
+	}
+	o, err := utilversion.ParseGeneric(other)
+	if err != nil {
+		return false
+	}
+	return v.LessThan(o)
+}
+
+// applyShootRegionProfile replaces the region specific parts of the shoot configuration.
+func applyShootRegionProfile(shootConfig *lssconfig.ShootConfiguration, profile *lssconfig.ShootRegionProfile) {
+	shootConfig.Region = profile.Region
+	shootConfig.Provider = profile.Provider
+	if profile.Machine != nil {
+		shootConfig.Workers.Machine = *profile.Machine
+	}
+	if profile.Volume != nil {
+		shootConfig.Workers.Volume = *profile.Volume
+	}
+}
+
+// applyShootProfile replaces the fields of the shoot configuration which are set in the shoot profile.
+func applyShootProfile(shootConfig *lssconfig.ShootConfiguration, profile *lssconfig.ShootProfile) {
+	if len(profile.MachineType) > 0 {
+		shootConfig.Workers.Machine.Type = profile.MachineType
+	}
+	if profile.Minimum != nil {
+		shootConfig.Workers.Minimum = ptr.To(*profile.Minimum)
+	}
+	if profile.Maximum != nil {
+		shootConfig.Workers.Maximum = ptr.To(*profile.Maximum)
+	}
+	if len(profile.VolumeSize) > 0 {
+		shootConfig.Workers.Volume.Size = profile.VolumeSize
+	}
+	if len(profile.KubernetesVersion) > 0 {
+		shootConfig.Kubernetes.Version = profile.KubernetesVersion
+	}
+	if profile.MaintenanceTimeWindow != nil {
+		shootConfig.Maintenance.TimeWindow = *profile.MaintenanceTimeWindow
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package instances
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssconfig "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// getSupportedVersion returns the supported landscaper service component version which is installed for the instance.
+// Instances without a version use the default version of the landscaper service component.
+func (c *Controller) getSupportedVersion(instance *lssv1alpha1.Instance) (*lssconfig.SupportedVersion, error) {
+	componentConfig := &c.Config().LandscaperServiceComponent
+
+	if len(instance.Spec.Version) == 0 {
+		if supportedVersion := componentConfig.GetSupportedVersion(componentConfig.Version); supportedVersion != nil {
+			return supportedVersion, nil
+		}
+		return &lssconfig.SupportedVersion{Version: componentConfig.Version}, nil
+	}
+
+	supportedVersion := componentConfig.GetSupportedVersion(instance.Spec.Version)
+	if supportedVersion == nil {
+		return nil, fmt.Errorf("version %q of the landscaper service component is not supported", instance.Spec.Version)
+	}
+	return supportedVersion, nil
+}
+
+// getInstallationVersion returns the landscaper service component version which is used for the installation of the instance.
+// A new default version is only installed for instances which already have another version installed,
+// when the upgrade has been approved by the upgrade controller. Until then, the installed version is kept.
+func (c *Controller) getInstallationVersion(instance *lssv1alpha1.Instance) (*lssconfig.SupportedVersion, error) {
+	supportedVersion, err := c.getSupportedVersion(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.UpgradeRequiresApproval(instance, supportedVersion.Version) || utils.IsUpgradeApproved(instance, supportedVersion.Version) {
+		setCondition(instance, lssv1alpha1.InstanceConditionVersionUpToDate, metav1.ConditionTrue,
+			"VersionUpToDate", fmt.Sprintf("version %s is installed", supportedVersion.Version))
+		return supportedVersion, nil
+	}
+
+	installedVersion := utils.InstalledVersion(instance)
+	setCondition(instance, lssv1alpha1.InstanceConditionVersionUpToDate, metav1.ConditionFalse,
+		"UpgradePending", fmt.Sprintf("upgrade from version %s to %s is pending", installedVersion, supportedVersion.Version))
+
+	if installed := c.Config().LandscaperServiceComponent.GetSupportedVersion(installedVersion); installed != nil {
+		return installed, nil
+	}
+	return &lssconfig.SupportedVersion{Version: installedVersion}, nil
+}
@@ -20,7 +20,7 @@ import (
 // AddControllerToManager adds the landscaperdeployments controller to the manager
 func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.LandscaperServiceConfiguration) error {
 	log := logger.Reconciles("landscaperDeployments", "LandscaperDeployments")
-	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), config)
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("landscaper-service-landscaperdeployments"), config)
 	if err != nil {
 		return err
 	}
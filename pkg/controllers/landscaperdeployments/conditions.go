@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscaperdeployments
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// setCondition sets a status condition of the landscaper deployment.
+func setCondition(deployment *lssv1alpha1.LandscaperDeployment, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&deployment.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: deployment.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// setScheduledCondition sets the Scheduled condition for an instance that has been assigned to a service target config.
+func setScheduledCondition(deployment *lssv1alpha1.LandscaperDeployment, instance *lssv1alpha1.Instance) {
+	setCondition(deployment, lssv1alpha1.LandscaperDeploymentConditionScheduled, metav1.ConditionTrue, "ServiceTargetConfigSelected",
+		fmt.Sprintf("instance is scheduled on service target config %s", instance.Spec.ServiceTargetConfigRef.NamespacedName().String()))
+}
+
+// setInstanceConditions mirrors the Ready and Healthy conditions of the instance.
+func setInstanceConditions(deployment *lssv1alpha1.LandscaperDeployment, instance *lssv1alpha1.Instance) {
+	ready := meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.InstanceConditionReady)
+	if ready != nil {
+		setCondition(deployment, lssv1alpha1.LandscaperDeploymentConditionReady, ready.Status, ready.Reason, ready.Message)
+	} else {
+		setCondition(deployment, lssv1alpha1.LandscaperDeploymentConditionReady, metav1.ConditionUnknown, "Progressing",
+			"waiting for the instance to be reconciled")
+	}
+
+	healthy := meta.FindStatusCondition(instance.Status.Conditions, lssv1alpha1.InstanceConditionHealthy)
+	if healthy != nil {
+		setCondition(deployment, lssv1alpha1.LandscaperDeploymentConditionHealthy, healthy.Status, healthy.Reason, healthy.Message)
+	} else {
+		meta.RemoveStatusCondition(&deployment.Status.Conditions, lssv1alpha1.LandscaperDeploymentConditionHealthy)
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -25,6 +26,7 @@ import (
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
 	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
 // Controller is the landscaperdeployments controller
@@ -52,7 +54,7 @@ func defaultUniqueIdFunc() string {
 }
 
 // NewController returns a new landscaperdeployments controller
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log:          logger,
 		UniqueIDFunc: defaultUniqueIdFunc,
@@ -60,6 +62,7 @@ func NewController(logger logging.Logger, c client.Client, scheme *runtime.Schem
 	ctrl.ReconcileFunc = ctrl.reconcile
 	ctrl.HandleDeleteFunc = ctrl.handleDelete
 	op := operation.NewOperation(c, scheme, config)
+	op.SetEventRecorder(eventRecorder)
 	ctrl.Operation = *op
 	return ctrl, nil
 }
@@ -124,6 +127,8 @@ func (c *Controller) handleErrorFunc(deployment *lssv1alpha1.LandscaperDeploymen
 	return func(ctx context.Context, err error) error {
 		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(deployment).String()})
 		deployment.Status.LastError = lsserrors.TryUpdateError(deployment.Status.LastError, err)
+		utils.RecordErrorEvent(c.EventRecorder(), deployment, "ReconcileFailed", err)
+		utils.RecordConditionEvents(c.EventRecorder(), deployment, old.Status.Conditions, deployment.Status.Conditions)
 
 		if !reflect.DeepEqual(old.Status, deployment.Status) {
 			if err2 := c.Client().Status().Update(ctx, deployment); err2 != nil {
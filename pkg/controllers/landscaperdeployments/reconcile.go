@@ -6,16 +6,17 @@ package landscaperdeployments
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,6 +25,7 @@ import (
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
 	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+	"github.com/gardener/landscaper-service/pkg/metrics"
 	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
@@ -46,14 +48,22 @@ func (c *Controller) reconcile(ctx context.Context, deployment *lssv1alpha1.Land
 		instance.Namespace = instanceRef.Namespace
 	}
 
-	_, err = kubernetes.CreateOrUpdate(ctx, c.Client(), instance, func() error {
+	result, err := kubernetes.CreateOrUpdate(ctx, c.Client(), instance, func() error {
 		return c.mutateInstance(ctx, deployment, instance)
 	})
 
 	if err != nil {
+		if errors.Is(err, lssscheduling.ErrNoCapacity) {
+			return lsserrors.NewWrappedError(err, currOp, "NoCapacity", err.Error())
+		}
 		return lsserrors.NewWrappedError(err, currOp, "CreateUpdateInstance", err.Error())
 	}
 
+	if result == controllerutil.OperationResultCreated {
+		c.EventRecorder().Eventf(deployment, corev1.EventTypeNormal, "InstanceCreated",
+			"created instance %s", client.ObjectKeyFromObject(instance).String())
+	}
+
 	// set the instance reference for the deployment if not already set
 	if deployment.Status.InstanceRef == nil || !deployment.Status.InstanceRef.IsObject(instance) {
 		deployment.Status.InstanceRef = &lssv1alpha1.ObjectReference{
@@ -67,6 +77,8 @@ func (c *Controller) reconcile(ctx context.Context, deployment *lssv1alpha1.Land
 	}
 
 	deployment.Status.Phase = instance.Status.Phase
+	setScheduledCondition(deployment, instance)
+	setInstanceConditions(deployment, instance)
 
 	if deployment.IsInternalDataPlane() {
 		deployment.Status.DataPlaneType = lssv1alpha1.LandscaperDeploymentDataPlaneTypeInternal
@@ -80,6 +92,11 @@ func (c *Controller) reconcile(ctx context.Context, deployment *lssv1alpha1.Land
 		}
 	}
 
+	if utils.IsOrphaned(instance) {
+		// the service target config of the instance has been deleted
+		return nil
+	}
+
 	// if not already added, add the instance reference to the service target configuration
 	serviceTargetConf := &lssv1alpha1.ServiceTargetConfig{}
 	if err := c.Client().Get(ctx, instance.Spec.ServiceTargetConfigRef.NamespacedName(), serviceTargetConf); err != nil {
@@ -155,17 +172,54 @@ func (c *Controller) mutateInstance(ctx context.Context, deployment *lssv1alpha1
 		instance.Spec.ID = id
 	}
 
+	// the labels of the deployment are evaluated by the anti-affinity terms of other deployments during their scheduling
+	copyDeploymentLabels(deployment, instance)
+
 	instance.Spec.TenantId = deployment.Spec.TenantId
 	instance.Spec.LandscaperConfiguration = deployment.Spec.LandscaperConfiguration
 	instance.Spec.OIDCConfig = deployment.Spec.OIDCConfig
 	instance.Spec.HighAvailabilityConfig = deployment.Spec.HighAvailabilityConfig
 	instance.Spec.DataPlane = deployment.Spec.DataPlane
+	instance.Spec.Version = deployment.Spec.Version
+	instance.Spec.MaintenanceWindow = deployment.Spec.MaintenanceWindow
+	instance.Spec.UserKubeconfigSecretName = deployment.Spec.UserKubeconfigSecretName
+	instance.Spec.Hibernation = deployment.Spec.Hibernation
+	instance.Spec.Region = deployment.Spec.Region
+	instance.Spec.ShootProfile = deployment.Spec.ShootProfile
 
 	c.Operation.Scheme().Default(instance)
 
 	return nil
 }
 
+// copyDeploymentLabels copies the labels of the deployment to the instance.
+// The labels which have been copied before, but which have been removed from the deployment, are removed from the instance.
+// The copied label keys are recorded in an annotation of the instance.
+func copyDeploymentLabels(deployment *lssv1alpha1.LandscaperDeployment, instance *lssv1alpha1.Instance) {
+	labels := deployment.GetLabels()
+
+	if copied, ok := instance.GetAnnotations()[lssv1alpha1.LandscaperServiceCopiedLabelsAnnotation]; ok {
+		for _, key := range strings.Split(copied, ",") {
+			if _, ok := labels[key]; !ok {
+				delete(instance.Labels, key)
+			}
+		}
+	}
+
+	if len(labels) == 0 {
+		delete(instance.Annotations, lssv1alpha1.LandscaperServiceCopiedLabelsAnnotation)
+		return
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key, value := range labels {
+		metav1.SetMetaDataLabel(&instance.ObjectMeta, key, value)
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	metav1.SetMetaDataAnnotation(&instance.ObjectMeta, lssv1alpha1.LandscaperServiceCopiedLabelsAnnotation, strings.Join(keys, ","))
+}
+
 func (c *Controller) findServiceTargetConfigByScheduling(ctx context.Context, deployment *lssv1alpha1.LandscaperDeployment) (*lssv1alpha1.ServiceTargetConfig, error) {
 	log, ctx := logging.FromContextOrNew(ctx, nil)
 
@@ -174,68 +228,44 @@ func (c *Controller) findServiceTargetConfigByScheduling(ctx context.Context, de
 		return nil, err
 	}
 
-	scheduling, err := c.getSchedulingResource(ctx)
+	scheduling, err := c.getMergedScheduling(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// determine a matching service target config
-	winner, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+	instanceList := &lssv1alpha1.InstanceList{}
+	if err := c.Client().List(ctx, instanceList); err != nil {
+		log.Error(err, "unable to list instances")
+		return nil, fmt.Errorf("unable to list instances: %w", err)
+	}
+
+	// determine a matching service target config and record the decision in the deployment status
+	winner, decision, err := lssscheduling.Schedule(scheduling.Scheduling, deployment, serviceTargetConfigs, instanceList.Items)
+	scheduling.ResolveMatchedRules(decision)
+	deployment.Status.SchedulingDecision = decision
 	if err != nil {
 		log.Error(err, "unable to find service target config")
+		reason := "SchedulingFailed"
+		if errors.Is(err, lssscheduling.ErrNoCapacity) {
+			reason = "NoCapacity"
+		}
+		setCondition(deployment, lssv1alpha1.LandscaperDeploymentConditionScheduled, metav1.ConditionFalse, reason, err.Error())
+		metrics.SchedulingFailures.WithLabelValues(reason).Inc()
 		return nil, fmt.Errorf("unable to find service target config: %w", err)
 	}
 
+	metrics.SchedulingDecisions.WithLabelValues(winner.GetNamespace(), winner.GetName()).Inc()
 	return winner, nil
 }
 
 func (c *Controller) getVisibleServiceTargetConfigs(ctx context.Context) ([]lssv1alpha1.ServiceTargetConfig, error) {
-	log, ctx := logging.FromContextOrNew(ctx, nil)
-
-	serviceTargetConfigList := &lssv1alpha1.ServiceTargetConfigList{}
-	selectorBuilder := strings.Builder{}
-	selectorBuilder.WriteString(fmt.Sprintf("%s=true", lssv1alpha1.ServiceTargetConfigVisibleLabelName))
-
-	labelSelector, _ := labels.Parse(selectorBuilder.String())
-	listOptions := client.ListOptions{
-		LabelSelector: labelSelector,
-	}
-
-	if err := c.Client().List(ctx, serviceTargetConfigList, &listOptions); err != nil {
-		log.Error(err, "unable to list service target configs")
-		return nil, fmt.Errorf("unable to list service target configs: %w", err)
-	}
-
-	return serviceTargetConfigList.Items, nil
+	return lssscheduling.GetVisibleServiceTargetConfigs(ctx, c.Client())
 }
 
-// getSchedulingResource returns the TargetScheduling resource from the core cluster.
-// Returns nil if scheduling is not configured or the scheduling resource does not exist.
-func (c *Controller) getSchedulingResource(ctx context.Context) (*lssv1alpha1.TargetScheduling, error) {
-	log, ctx := logging.FromContextOrNew(ctx, nil)
-
-	schedulingConfig := c.Config().Scheduling
-	if schedulingConfig == nil {
-		log.Info("no scheduling configured")
-		return nil, nil
-	}
-
-	schedulingKey := client.ObjectKey{
-		Namespace: c.Config().Scheduling.Namespace,
-		Name:      c.Config().Scheduling.Name,
-	}
-	scheduling := &lssv1alpha1.TargetScheduling{}
-	if err := c.Client().Get(ctx, schedulingKey, scheduling); err != nil {
-		if apierrors.IsNotFound(err) {
-			log.Info("no scheduling resource configured")
-			return nil, nil
-		}
-
-		log.Error(err, "unable to get scheduling object", lc.KeyResource, schedulingKey.String())
-		return nil, fmt.Errorf("unable to get scheduling object %s: %w", schedulingKey.String(), err)
-	}
-
-	return scheduling, nil
+// getMergedScheduling returns the merged rules of the configured and selected TargetScheduling resources from the core cluster.
+// The merged scheduling is empty if scheduling is not configured or no scheduling resource exists.
+func (c *Controller) getMergedScheduling(ctx context.Context) (*lssscheduling.MergedScheduling, error) {
+	return lssscheduling.GetMergedScheduling(ctx, c.Client(), c.Config().Scheduling, c.Config().SchedulingSelector)
 }
 
 // getInstanceRef returns a reference to the instance owned by the deployment, or nil if there is no such instance.
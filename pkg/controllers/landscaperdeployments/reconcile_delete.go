@@ -10,12 +10,14 @@ import (
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	"github.com/gardener/landscaper-service/pkg/metrics"
 )
 
 // handleDelete handles the deletion of a landscaper deployment.
@@ -36,6 +38,8 @@ func (c *Controller) handleDelete(ctx context.Context, deployment *lssv1alpha1.L
 		if err = c.Client().Update(ctx, deployment); err != nil {
 			return lsserrors.NewWrappedError(err, currOp, "RemoveFinalizer", err.Error())
 		}
+		metrics.ObserveDeletionDuration("LandscaperDeployment", deployment)
+		c.EventRecorder().Event(deployment, corev1.EventTypeNormal, "Deleted", "the instance of the landscaper deployment has been deleted")
 	}
 
 	return nil
@@ -74,6 +78,8 @@ func (c *Controller) ensureDeleteInstanceForDeployment(ctx context.Context, depl
 		if err := c.Client().Delete(ctx, instance); err != nil {
 			return false, fmt.Errorf("unable to delete instance: %w", err)
 		}
+		c.EventRecorder().Eventf(deployment, corev1.EventTypeNormal, "DeletingInstance",
+			"deleting instance %s", client.ObjectKeyFromObject(instance).String())
 	}
 
 	return false, nil
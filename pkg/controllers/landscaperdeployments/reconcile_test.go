@@ -14,6 +14,8 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
@@ -86,6 +88,17 @@ var _ = Describe("Reconcile", func() {
 		Expect(config.Status.InstanceRefs).To(HaveLen(1))
 		Expect(config.Status.InstanceRefs[0].Name).To(Equal(instance.Name))
 		Expect(config.Status.InstanceRefs[0].Namespace).To(Equal(instance.Namespace))
+
+		Expect(deployment.Status.SchedulingDecision).ToNot(BeNil())
+		Expect(deployment.Status.SchedulingDecision.Selected).ToNot(BeNil())
+		Expect(deployment.Status.SchedulingDecision.Selected.Name).To(Equal("config3"))
+		Expect(deployment.Status.SchedulingDecision.Candidates).To(HaveLen(3))
+		Expect(deployment.Status.SchedulingDecision.Candidates[0].ServiceTargetConfig.Name).To(Equal("config3"))
+
+		Expect(meta.IsStatusConditionTrue(deployment.Status.Conditions, lssv1alpha1.LandscaperDeploymentConditionScheduled)).To(BeTrue())
+		ready := meta.FindStatusCondition(deployment.Status.Conditions, lssv1alpha1.LandscaperDeploymentConditionReady)
+		Expect(ready).ToNot(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionUnknown))
 	})
 
 	It("should not create an instance when no target configuration is available", func() {
@@ -98,7 +111,13 @@ var _ = Describe("Reconcile", func() {
 		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
 		testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
 		Expect(deployment.Status.InstanceRef).To(BeNil())
+
+		scheduled := meta.FindStatusCondition(deployment.Status.Conditions, lssv1alpha1.LandscaperDeploymentConditionScheduled)
+		Expect(scheduled).ToNot(BeNil())
+		Expect(scheduled.Status).To(Equal(metav1.ConditionFalse))
+		Expect(scheduled.Reason).To(Equal("SchedulingFailed"))
 	})
 
 	It("should mutate an existing instance", func() {
@@ -130,6 +149,51 @@ var _ = Describe("Reconcile", func() {
 		Expect(instance.Spec.ID).To(Equal(uid))
 	})
 
+	It("should remove the labels from the instance which have been removed from the deployment", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		deployment := state.GetDeployment("test")
+		deployment.SetLabels(map[string]string{"team": "a", "stage": "dev"})
+		Expect(testenv.Client.Update(ctx, deployment)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(deployment.Status.InstanceRef).ToNot(BeNil())
+
+		instance := &lssv1alpha1.Instance{}
+		Expect(testenv.Client.Get(ctx, deployment.Status.InstanceRef.NamespacedName(), instance)).To(Succeed())
+		Expect(instance.Labels).To(HaveKeyWithValue("team", "a"))
+		Expect(instance.Labels).To(HaveKeyWithValue("stage", "dev"))
+		Expect(instance.Annotations).To(HaveKeyWithValue(lssv1alpha1.LandscaperServiceCopiedLabelsAnnotation, "stage,team"))
+
+		// a label which has not been copied from the deployment is kept
+		metav1.SetMetaDataLabel(&instance.ObjectMeta, "other", "x")
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		deployment.SetLabels(map[string]string{"team": "b"})
+		Expect(testenv.Client.Update(ctx, deployment)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
+
+		Expect(testenv.Client.Get(ctx, deployment.Status.InstanceRef.NamespacedName(), instance)).To(Succeed())
+		Expect(instance.Labels).To(HaveKeyWithValue("team", "b"))
+		Expect(instance.Labels).ToNot(HaveKey("stage"))
+		Expect(instance.Labels).To(HaveKeyWithValue("other", "x"))
+		Expect(instance.Annotations).To(HaveKeyWithValue(lssv1alpha1.LandscaperServiceCopiedLabelsAnnotation, "team"))
+
+		deployment.SetLabels(nil)
+		Expect(testenv.Client.Update(ctx, deployment)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(deployment))
+
+		Expect(testenv.Client.Get(ctx, deployment.Status.InstanceRef.NamespacedName(), instance)).To(Succeed())
+		Expect(instance.Labels).ToNot(HaveKey("team"))
+		Expect(instance.Labels).To(HaveKeyWithValue("other", "x"))
+		Expect(instance.Annotations).ToNot(HaveKey(lssv1alpha1.LandscaperServiceCopiedLabelsAnnotation))
+	})
+
 	It("should not create instances with duplicated ids", func() {
 		var err error
 
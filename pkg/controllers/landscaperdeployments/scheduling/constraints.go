@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+const (
+	// FilterReasonSpreadConstraint is the filter reason of a candidate that violates a spread constraint.
+	FilterReasonSpreadConstraint = "SpreadConstraint"
+	// FilterReasonAntiAffinity is the filter reason of a candidate that violates an anti-affinity term.
+	FilterReasonAntiAffinity = "AntiAffinity"
+)
+
+// collectConstraints returns the scheduling constraints of the applied rules and of the deployment.
+func collectConstraints(
+	scheduling *lssv1alpha1.TargetScheduling,
+	matchedRules []lssv1alpha1.MatchedSchedulingRule,
+	deployment *lssv1alpha1.LandscaperDeployment,
+) lssv1alpha1.SchedulingConstraints {
+
+	result := lssv1alpha1.SchedulingConstraints{}
+
+	add := func(constraints *lssv1alpha1.SchedulingConstraints) {
+		if constraints == nil {
+			return
+		}
+		result.SpreadConstraints = append(result.SpreadConstraints, constraints.SpreadConstraints...)
+		result.AntiAffinity = append(result.AntiAffinity, constraints.AntiAffinity...)
+	}
+
+	if scheduling != nil {
+		for _, matchedRule := range matchedRules {
+			if matchedRule.Applied {
+				add(scheduling.Spec.Rules[matchedRule.Index].Constraints)
+			}
+		}
+	}
+	add(deployment.Spec.SchedulingConstraints)
+
+	return result
+}
+
+// filterByConstraints removes the ServiceTargetConfigs which violate a spread constraint or an anti-affinity term
+// with action DoNotSchedule. The removed ServiceTargetConfigs are returned as filtered candidates.
+// The remaining ServiceTargetConfigs are returned together with the number of violated constraints with action ScheduleAnyway.
+// The instances are the already existing instances, the serviceTargetConfigs are used to determine their topology domains.
+func filterByConstraints(
+	configs []*lssv1alpha1.ServiceTargetConfig,
+	constraints lssv1alpha1.SchedulingConstraints,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	instances []lssv1alpha1.Instance,
+) ([]*lssv1alpha1.ServiceTargetConfig, []lssv1alpha1.SchedulingCandidate, map[*lssv1alpha1.ServiceTargetConfig]int) {
+
+	filterReasons := make(map[*lssv1alpha1.ServiceTargetConfig]string)
+	penalties := make(map[*lssv1alpha1.ServiceTargetConfig]int)
+
+	violate := func(config *lssv1alpha1.ServiceTargetConfig, action lssv1alpha1.UnsatisfiableConstraintAction, reason string) {
+		if action == lssv1alpha1.ScheduleAnyway {
+			penalties[config]++
+		} else if _, ok := filterReasons[config]; !ok {
+			filterReasons[config] = reason
+		}
+	}
+
+	others := make([]*lssv1alpha1.Instance, 0, len(instances))
+	for i := range instances {
+		if !isInstanceOfDeployment(&instances[i], deployment) {
+			others = append(others, &instances[i])
+		}
+	}
+
+	for _, constraint := range constraints.SpreadConstraints {
+		for _, config := range violatesSpreadConstraint(configs, constraint, deployment, serviceTargetConfigs, others) {
+			violate(config, constraint.WhenUnsatisfiable, FilterReasonSpreadConstraint)
+		}
+	}
+
+	for _, term := range constraints.AntiAffinity {
+		for _, config := range violatesAntiAffinity(configs, term, deployment, serviceTargetConfigs, others) {
+			violate(config, term.WhenUnsatisfiable, FilterReasonAntiAffinity)
+		}
+	}
+
+	result := make([]*lssv1alpha1.ServiceTargetConfig, 0, len(configs))
+	filtered := make([]lssv1alpha1.SchedulingCandidate, 0)
+	for _, config := range configs {
+		if reason, ok := filterReasons[config]; ok {
+			filtered = append(filtered, newSchedulingCandidate(config, reason))
+		} else {
+			result = append(result, config)
+		}
+	}
+
+	return result, filtered, penalties
+}
+
+// violatesSpreadConstraint returns the ServiceTargetConfigs on which another instance of the tenant of the deployment
+// would exceed the maximum skew of the given spread constraint.
+// The skew of a topology domain is the number of instances of the tenant in this domain after the scheduling,
+// minus the minimum number of instances of the tenant in any of the domains of the candidates.
+func violatesSpreadConstraint(
+	configs []*lssv1alpha1.ServiceTargetConfig,
+	constraint lssv1alpha1.SpreadConstraint,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	instances []*lssv1alpha1.Instance,
+) []*lssv1alpha1.ServiceTargetConfig {
+
+	if len(configs) == 0 {
+		return nil
+	}
+
+	maxSkew := constraint.MaxSkew
+	if maxSkew <= 0 {
+		maxSkew = 1
+	}
+
+	counts := make(map[string]int)
+	for _, config := range configs {
+		counts[configDomain(config, constraint.TopologyKey)] = 0
+	}
+
+	for _, instance := range instances {
+		if instance.Spec.TenantId != deployment.Spec.TenantId {
+			continue
+		}
+		domain := instanceDomain(instance, constraint.TopologyKey, serviceTargetConfigs)
+		if _, ok := counts[domain]; ok {
+			counts[domain]++
+		}
+	}
+
+	minCount := -1
+	for _, count := range counts {
+		if minCount < 0 || count < minCount {
+			minCount = count
+		}
+	}
+
+	violating := make([]*lssv1alpha1.ServiceTargetConfig, 0)
+	for _, config := range configs {
+		if counts[configDomain(config, constraint.TopologyKey)]+1-minCount > maxSkew {
+			violating = append(violating, config)
+		}
+	}
+	return violating
+}
+
+// violatesAntiAffinity returns the ServiceTargetConfigs whose topology domain already contains an instance of another
+// deployment with the same value of the label of the given anti-affinity term.
+// If the deployment does not have the label, the term does not apply.
+func violatesAntiAffinity(
+	configs []*lssv1alpha1.ServiceTargetConfig,
+	term lssv1alpha1.AntiAffinityTerm,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	instances []*lssv1alpha1.Instance,
+) []*lssv1alpha1.ServiceTargetConfig {
+
+	value, ok := deployment.GetLabels()[term.LabelKey]
+	if !ok {
+		return nil
+	}
+
+	occupied := make(map[string]bool)
+	for _, instance := range instances {
+		if otherValue, ok := instance.GetLabels()[term.LabelKey]; ok && otherValue == value {
+			occupied[instanceDomain(instance, term.TopologyKey, serviceTargetConfigs)] = true
+		}
+	}
+
+	violating := make([]*lssv1alpha1.ServiceTargetConfig, 0)
+	for _, config := range configs {
+		if occupied[configDomain(config, term.TopologyKey)] {
+			violating = append(violating, config)
+		}
+	}
+	return violating
+}
+
+// sortByPenalty sorts the ServiceTargetConfigs ascending by the number of violated constraints with action ScheduleAnyway.
+// ServiceTargetConfigs with the same number of violations keep their order.
+func sortByPenalty(configs []*lssv1alpha1.ServiceTargetConfig, penalties map[*lssv1alpha1.ServiceTargetConfig]int) {
+	sort.SliceStable(configs, func(i, j int) bool {
+		return penalties[configs[i]] < penalties[configs[j]]
+	})
+}
+
+// configDomain returns the topology domain of a ServiceTargetConfig.
+func configDomain(config *lssv1alpha1.ServiceTargetConfig, topologyKey string) string {
+	if len(topologyKey) > 0 {
+		if value, ok := config.GetLabels()[topologyKey]; ok {
+			return "topology:" + value
+		}
+	}
+	return "config:" + config.Namespace + "/" + config.Name
+}
+
+// instanceDomain returns the topology domain of the ServiceTargetConfig on which an instance is scheduled.
+func instanceDomain(instance *lssv1alpha1.Instance, topologyKey string, serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig) string {
+	ref := instance.Spec.ServiceTargetConfigRef
+	for i := range serviceTargetConfigs {
+		config := &serviceTargetConfigs[i]
+		if config.Name == ref.Name && config.Namespace == ref.Namespace {
+			return configDomain(config, topologyKey)
+		}
+	}
+	return "config:" + ref.Namespace + "/" + ref.Name
+}
+
+// isInstanceOfDeployment checks whether the instance belongs to the deployment.
+// The instance of a deployment must not count against the deployment, e.g. when it is migrated to another ServiceTargetConfig.
+func isInstanceOfDeployment(instance *lssv1alpha1.Instance, deployment *lssv1alpha1.LandscaperDeployment) bool {
+	if ref := deployment.Status.InstanceRef; ref != nil && ref.Name == instance.Name && ref.Namespace == instance.Namespace {
+		return true
+	}
+	return len(deployment.GetUID()) > 0 && metav1.IsControlledBy(instance, deployment)
+}
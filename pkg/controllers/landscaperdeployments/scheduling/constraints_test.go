@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+)
+
+var _ = Describe("Constraints", func() {
+
+	const (
+		namespace1 = "test-namespace-1"
+
+		config1 = "test-config-1"
+		config2 = "test-config-2"
+		config3 = "test-config-3"
+
+		tenant1 = "test-tenant-1"
+		tenant2 = "test-tenant-2"
+
+		regionKey = "topology.landscaper-service.gardener.cloud/region"
+		appKey    = "app"
+	)
+
+	buildLandscaperDeployment := func(tenantID string, labels map[string]string) *lssv1alpha1.LandscaperDeployment {
+		return &lssv1alpha1.LandscaperDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-deployment",
+				Namespace: namespace1,
+				Labels:    labels,
+			},
+			Spec: lssv1alpha1.LandscaperDeploymentSpec{
+				TenantId: tenantID,
+			},
+		}
+	}
+
+	buildServiceTargetConfig := func(name string, prio int64, region string) lssv1alpha1.ServiceTargetConfig {
+		config := lssv1alpha1.ServiceTargetConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace1,
+			},
+			Spec: lssv1alpha1.ServiceTargetConfigSpec{
+				Priority: prio,
+			},
+		}
+		if len(region) > 0 {
+			config.Labels = map[string]string{regionKey: region}
+		}
+		return config
+	}
+
+	buildInstance := func(name, tenantID, config string, labels map[string]string) lssv1alpha1.Instance {
+		return lssv1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace1,
+				Labels:    labels,
+			},
+			Spec: lssv1alpha1.InstanceSpec{
+				TenantId: tenantID,
+				ServiceTargetConfigRef: lssv1alpha1.ObjectReference{
+					Name:      config,
+					Namespace: namespace1,
+				},
+			},
+		}
+	}
+
+	filterReasons := func(decision *lssv1alpha1.SchedulingDecision) map[string]string {
+		result := map[string]string{}
+		for _, candidate := range decision.Candidates {
+			result[candidate.ServiceTargetConfig.Name] = candidate.FilterReason
+		}
+		return result
+	}
+
+	It("should spread the deployments of a tenant over the service target configs", func() {
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			buildServiceTargetConfig(config1, 100, ""),
+			buildServiceTargetConfig(config2, 20, ""),
+			buildServiceTargetConfig(config3, 10, ""),
+		}
+		instances := []lssv1alpha1.Instance{
+			buildInstance("instance-1", tenant1, config1, nil),
+			buildInstance("instance-2", tenant2, config2, nil),
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+		winner, _, err := lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, instances)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(winner.Name).To(Equal(config1))
+
+		deployment.Spec.SchedulingConstraints = &lssv1alpha1.SchedulingConstraints{
+			SpreadConstraints: []lssv1alpha1.SpreadConstraint{{}},
+		}
+		winner, decision, err := lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, instances)
+		Expect(err).ToNot(HaveOccurred())
+		// the instance of the other tenant does not count
+		Expect(winner.Name).To(Equal(config2))
+		Expect(filterReasons(decision)).To(Equal(map[string]string{
+			config1: lssscheduling.FilterReasonSpreadConstraint,
+			config2: "",
+			config3: "",
+		}))
+
+		// a larger skew allows a second instance on the same service target config
+		deployment.Spec.SchedulingConstraints.SpreadConstraints[0].MaxSkew = 2
+		winner, _, err = lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, instances)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(winner.Name).To(Equal(config1))
+	})
+
+	It("should spread the deployments of a tenant over the topology domains of a rule", func() {
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			buildServiceTargetConfig(config1, 100, "eu"),
+			buildServiceTargetConfig(config2, 50, "eu"),
+			buildServiceTargetConfig(config3, 10, "us"),
+		}
+		instances := []lssv1alpha1.Instance{
+			buildInstance("instance-1", tenant1, config1, nil),
+		}
+
+		scheduling := &lssv1alpha1.TargetScheduling{
+			Spec: lssv1alpha1.TargetSchedulingSpec{
+				Rules: []lssv1alpha1.SchedulingRule{
+					{
+						Priority: 1,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config1, Namespace: namespace1},
+							{Name: config2, Namespace: namespace1},
+							{Name: config3, Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+						},
+						Constraints: &lssv1alpha1.SchedulingConstraints{
+							SpreadConstraints: []lssv1alpha1.SpreadConstraint{{TopologyKey: regionKey}},
+						},
+					},
+				},
+			},
+		}
+
+		winner, decision, err := lssscheduling.Schedule(scheduling, buildLandscaperDeployment(tenant1, nil), serviceTargetConfigs, instances)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(winner.Name).To(Equal(config3))
+		Expect(filterReasons(decision)).To(Equal(map[string]string{
+			config1: lssscheduling.FilterReasonSpreadConstraint,
+			config2: lssscheduling.FilterReasonSpreadConstraint,
+			config3: "",
+		}))
+
+		// the constraints of a rule only apply to the deployments to which the rule is applied
+		winner, _, err = lssscheduling.Schedule(scheduling, buildLandscaperDeployment(tenant2, nil), serviceTargetConfigs, instances)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(winner.Name).To(Equal(config1))
+	})
+
+	It("should keep deployments with the same label value apart", func() {
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			buildServiceTargetConfig(config1, 100, ""),
+			buildServiceTargetConfig(config2, 10, ""),
+		}
+		instances := []lssv1alpha1.Instance{
+			buildInstance("instance-1", tenant2, config1, map[string]string{appKey: "a"}),
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, map[string]string{appKey: "a"})
+		deployment.Spec.SchedulingConstraints = &lssv1alpha1.SchedulingConstraints{
+			AntiAffinity: []lssv1alpha1.AntiAffinityTerm{{LabelKey: appKey}},
+		}
+
+		winner, decision, err := lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, instances)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(winner.Name).To(Equal(config2))
+		Expect(filterReasons(decision)).To(Equal(map[string]string{
+			config1: lssscheduling.FilterReasonAntiAffinity,
+			config2: "",
+		}))
+
+		// deployments with another label value are not affected
+		deployment.Labels[appKey] = "b"
+		winner, _, err = lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, instances)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(winner.Name).To(Equal(config1))
+	})
+
+	It("should not count the instance of the deployment itself", func() {
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			buildServiceTargetConfig(config1, 100, ""),
+			buildServiceTargetConfig(config2, 10, ""),
+		}
+		instances := []lssv1alpha1.Instance{
+			buildInstance("instance-1", tenant1, config1, map[string]string{appKey: "a"}),
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, map[string]string{appKey: "a"})
+		deployment.Status.InstanceRef = &lssv1alpha1.ObjectReference{Name: "instance-1", Namespace: namespace1}
+		deployment.Spec.SchedulingConstraints = &lssv1alpha1.SchedulingConstraints{
+			SpreadConstraints: []lssv1alpha1.SpreadConstraint{{}},
+			AntiAffinity:      []lssv1alpha1.AntiAffinityTerm{{LabelKey: appKey}},
+		}
+
+		winner, _, err := lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, instances)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(winner.Name).To(Equal(config1))
+	})
+
+	It("should prefer service target configs which satisfy soft constraints", func() {
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			buildServiceTargetConfig(config1, 100, ""),
+			buildServiceTargetConfig(config2, 10, ""),
+		}
+		instances := []lssv1alpha1.Instance{
+			buildInstance("instance-1", tenant2, config1, map[string]string{appKey: "a"}),
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, map[string]string{appKey: "a"})
+		deployment.Spec.SchedulingConstraints = &lssv1alpha1.SchedulingConstraints{
+			AntiAffinity: []lssv1alpha1.AntiAffinityTerm{{LabelKey: appKey, WhenUnsatisfiable: lssv1alpha1.ScheduleAnyway}},
+		}
+
+		winner, decision, err := lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, instances)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(winner.Name).To(Equal(config2))
+		Expect(decision.Candidates).To(HaveLen(2))
+		Expect(decision.Candidates[0].ServiceTargetConfig.Name).To(Equal(config2))
+		Expect(decision.Candidates[1].ServiceTargetConfig.Name).To(Equal(config1))
+
+		// if all service target configs violate the constraint, one of them is selected anyway
+		instances = append(instances, buildInstance("instance-2", tenant2, config2, map[string]string{appKey: "a"}))
+		winner, _, err = lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, instances)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(winner.Name).To(Equal(config1))
+	})
+
+	It("should fail if no service target config satisfies the constraints", func() {
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			buildServiceTargetConfig(config1, 100, "eu"),
+			buildServiceTargetConfig(config2, 10, "eu"),
+		}
+		instances := []lssv1alpha1.Instance{
+			buildInstance("instance-1", tenant2, config1, map[string]string{appKey: "a"}),
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, map[string]string{appKey: "a"})
+		deployment.Spec.SchedulingConstraints = &lssv1alpha1.SchedulingConstraints{
+			AntiAffinity: []lssv1alpha1.AntiAffinityTerm{{LabelKey: appKey, TopologyKey: regionKey}},
+		}
+
+		_, decision, err := lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, instances)
+		Expect(err).To(HaveOccurred())
+		Expect(decision.Selected).To(BeNil())
+		Expect(filterReasons(decision)).To(Equal(map[string]string{
+			config1: lssscheduling.FilterReasonAntiAffinity,
+			config2: lssscheduling.FilterReasonAntiAffinity,
+		}))
+	})
+})
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// RuleOrigin identifies a rule of a TargetScheduling.
+type RuleOrigin struct {
+	// Scheduling references the TargetScheduling, which contains the rule.
+	Scheduling lssv1alpha1.ObjectReference
+	// Index is the index of the rule in the list of rules of the TargetScheduling.
+	Index int
+}
+
+// String returns a human-readable representation of the rule origin.
+func (o RuleOrigin) String() string {
+	return fmt.Sprintf("rule %d of target scheduling %s", o.Index, o.Scheduling.NamespacedName().String())
+}
+
+// RuleConflict describes two rules of different TargetSchedulings, which have the same selector,
+// but assign different priorities or ServiceTargetConfigs.
+type RuleConflict struct {
+	// First is the rule, which has been merged first.
+	First RuleOrigin
+	// Second is the conflicting rule, which has been merged later.
+	Second RuleOrigin
+	// Message describes the conflict.
+	Message string
+}
+
+// MergedScheduling contains the merged rules of several TargetSchedulings.
+type MergedScheduling struct {
+	// Scheduling contains the rules of all merged TargetSchedulings.
+	// It is nil if no TargetScheduling has been merged.
+	Scheduling *lssv1alpha1.TargetScheduling
+	// Origins contains for every merged rule the TargetScheduling and the index of the rule within it.
+	Origins []RuleOrigin
+	// Conflicts contains the conflicts between rules of different TargetSchedulings.
+	Conflicts []RuleConflict
+}
+
+// MergeTargetSchedulings concatenates the rules of the given TargetSchedulings in the given order.
+// Rules of different TargetSchedulings with semantically equal selectors, but different priorities or ServiceTargetConfigs,
+// are reported as conflicts. Conflicting rules are merged nevertheless, so that the scheduling behaves as if all rules
+// were defined in one TargetScheduling.
+func MergeTargetSchedulings(schedulings []lssv1alpha1.TargetScheduling) *MergedScheduling {
+	merged := &MergedScheduling{
+		Origins:   make([]RuleOrigin, 0),
+		Conflicts: make([]RuleConflict, 0),
+	}
+
+	if len(schedulings) == 0 {
+		return merged
+	}
+
+	merged.Scheduling = &lssv1alpha1.TargetScheduling{}
+	if len(schedulings) == 1 {
+		merged.Scheduling.ObjectMeta = *schedulings[0].ObjectMeta.DeepCopy()
+	}
+
+	for i := range schedulings {
+		scheduling := &schedulings[i]
+		ref := lssv1alpha1.ObjectReference{
+			Name:      scheduling.Name,
+			Namespace: scheduling.Namespace,
+		}
+
+		for j := range scheduling.Spec.Rules {
+			rule := &scheduling.Spec.Rules[j]
+			origin := RuleOrigin{Scheduling: ref, Index: j}
+
+			for k := range merged.Scheduling.Spec.Rules {
+				if merged.Origins[k].Scheduling == ref {
+					continue
+				}
+				if message, ok := conflictMessage(&merged.Scheduling.Spec.Rules[k], rule); ok {
+					merged.Conflicts = append(merged.Conflicts, RuleConflict{
+						First:   merged.Origins[k],
+						Second:  origin,
+						Message: fmt.Sprintf("%s and %s have the same selector, but %s", merged.Origins[k], origin, message),
+					})
+				}
+			}
+
+			merged.Scheduling.Spec.Rules = append(merged.Scheduling.Spec.Rules, *rule.DeepCopy())
+			merged.Origins = append(merged.Origins, origin)
+		}
+	}
+
+	return merged
+}
+
+// conflictMessage checks whether the given rules have the same selector, but different priorities or ServiceTargetConfigs.
+func conflictMessage(first, second *lssv1alpha1.SchedulingRule) (string, bool) {
+	if !apiequality.Semantic.DeepEqual(first.Selector, second.Selector) {
+		return "", false
+	}
+
+	if first.Priority != second.Priority {
+		return fmt.Sprintf("different priorities %d and %d", first.Priority, second.Priority), true
+	}
+
+	if !apiequality.Semantic.DeepEqual(first.ServiceTargetConfigs, second.ServiceTargetConfigs) {
+		return "different service target configs", true
+	}
+
+	return "", false
+}
+
+// ConflictsOf returns the messages of the conflicts in which a rule of the given TargetScheduling is involved.
+func (m *MergedScheduling) ConflictsOf(scheduling lssv1alpha1.ObjectReference) []string {
+	messages := make([]string, 0)
+	for _, conflict := range m.Conflicts {
+		if conflict.First.Scheduling == scheduling || conflict.Second.Scheduling == scheduling {
+			messages = append(messages, conflict.Message)
+		}
+	}
+	return messages
+}
+
+// ResolveMatchedRules replaces the indices of the matched rules of the given scheduling decision, which refer to the
+// merged rules, with the TargetScheduling and the index of the rules within it.
+func (m *MergedScheduling) ResolveMatchedRules(decision *lssv1alpha1.SchedulingDecision) {
+	if decision == nil {
+		return
+	}
+
+	for i := range decision.MatchedRules {
+		matchedRule := &decision.MatchedRules[i]
+		if matchedRule.Index < 0 || matchedRule.Index >= len(m.Origins) {
+			continue
+		}
+		origin := m.Origins[matchedRule.Index]
+		matchedRule.Scheduling = &lssv1alpha1.ObjectReference{
+			Name:      origin.Scheduling.Name,
+			Namespace: origin.Scheduling.Namespace,
+		}
+		matchedRule.Index = origin.Index
+	}
+}
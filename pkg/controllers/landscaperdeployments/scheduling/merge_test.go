@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+)
+
+var _ = Describe("Merge", func() {
+
+	const (
+		namespace1 = "test-namespace-1"
+		namespace2 = "test-namespace-2"
+
+		config1 = "test-config-1"
+		config2 = "test-config-2"
+
+		tenant1 = "test-tenant-1"
+		tenant2 = "test-tenant-2"
+		tenant3 = "test-tenant-3"
+	)
+
+	buildRule := func(prio int64, tenantID, config string) lssv1alpha1.SchedulingRule {
+		return lssv1alpha1.SchedulingRule{
+			Priority: prio,
+			ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+				{Name: config, Namespace: namespace1},
+			},
+			Selector: []lssv1alpha1.Selector{
+				{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenantID}},
+			},
+		}
+	}
+
+	buildTargetScheduling := func(name, namespace string, rules ...lssv1alpha1.SchedulingRule) lssv1alpha1.TargetScheduling {
+		return lssv1alpha1.TargetScheduling{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{"team": name},
+			},
+			Spec: lssv1alpha1.TargetSchedulingSpec{
+				Rules: rules,
+			},
+		}
+	}
+
+	It("should return an empty merged scheduling if there are no target schedulings", func() {
+		merged := lssscheduling.MergeTargetSchedulings(nil)
+		Expect(merged.Scheduling).To(BeNil())
+		Expect(merged.Origins).To(BeEmpty())
+		Expect(merged.Conflicts).To(BeEmpty())
+	})
+
+	It("should concatenate the rules and record their origins", func() {
+		schedulings := []lssv1alpha1.TargetScheduling{
+			buildTargetScheduling("a", namespace1, buildRule(10, tenant1, config1), buildRule(5, tenant2, config1)),
+			buildTargetScheduling("b", namespace2, buildRule(20, tenant3, config2)),
+		}
+
+		merged := lssscheduling.MergeTargetSchedulings(schedulings)
+		Expect(merged.Scheduling).ToNot(BeNil())
+		Expect(merged.Scheduling.Spec.Rules).To(Equal([]lssv1alpha1.SchedulingRule{
+			buildRule(10, tenant1, config1),
+			buildRule(5, tenant2, config1),
+			buildRule(20, tenant3, config2),
+		}))
+		Expect(merged.Origins).To(Equal([]lssscheduling.RuleOrigin{
+			{Scheduling: lssv1alpha1.ObjectReference{Name: "a", Namespace: namespace1}, Index: 0},
+			{Scheduling: lssv1alpha1.ObjectReference{Name: "a", Namespace: namespace1}, Index: 1},
+			{Scheduling: lssv1alpha1.ObjectReference{Name: "b", Namespace: namespace2}, Index: 0},
+		}))
+		Expect(merged.Conflicts).To(BeEmpty())
+	})
+
+	It("should report rules of different target schedulings with the same selector as conflicts", func() {
+		schedulings := []lssv1alpha1.TargetScheduling{
+			buildTargetScheduling("a", namespace1, buildRule(10, tenant1, config1), buildRule(10, tenant1, config2)),
+			buildTargetScheduling("b", namespace2, buildRule(20, tenant1, config1)),
+			buildTargetScheduling("c", namespace2, buildRule(10, tenant1, config1)),
+		}
+
+		merged := lssscheduling.MergeTargetSchedulings(schedulings)
+		Expect(merged.Scheduling.Spec.Rules).To(HaveLen(4))
+
+		// rules within one target scheduling and identical rules are not conflicting
+		Expect(merged.Conflicts).To(HaveLen(4))
+		Expect(merged.Conflicts[0].First).To(Equal(merged.Origins[0]))
+		Expect(merged.Conflicts[0].Second).To(Equal(merged.Origins[2]))
+		Expect(merged.Conflicts[0].Message).To(ContainSubstring("different priorities 10 and 20"))
+		Expect(merged.Conflicts[1].First).To(Equal(merged.Origins[1]))
+		Expect(merged.Conflicts[1].Second).To(Equal(merged.Origins[2]))
+		Expect(merged.Conflicts[2].First).To(Equal(merged.Origins[1]))
+		Expect(merged.Conflicts[2].Second).To(Equal(merged.Origins[3]))
+		Expect(merged.Conflicts[2].Message).To(ContainSubstring("different service target configs"))
+		Expect(merged.Conflicts[3].First).To(Equal(merged.Origins[2]))
+		Expect(merged.Conflicts[3].Second).To(Equal(merged.Origins[3]))
+		Expect(merged.Conflicts[3].Message).To(ContainSubstring("different priorities 20 and 10"))
+
+		Expect(merged.ConflictsOf(lssv1alpha1.ObjectReference{Name: "a", Namespace: namespace1})).To(HaveLen(3))
+		Expect(merged.ConflictsOf(lssv1alpha1.ObjectReference{Name: "b", Namespace: namespace2})).To(HaveLen(3))
+		Expect(merged.ConflictsOf(lssv1alpha1.ObjectReference{Name: "c", Namespace: namespace2})).To(HaveLen(2))
+	})
+
+	It("should resolve the matched rules of a scheduling decision", func() {
+		schedulings := []lssv1alpha1.TargetScheduling{
+			buildTargetScheduling("a", namespace1, buildRule(10, tenant2, config1)),
+			buildTargetScheduling("b", namespace2, buildRule(20, tenant1, config2)),
+		}
+		merged := lssscheduling.MergeTargetSchedulings(schedulings)
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      config2,
+					Namespace: namespace1,
+					Labels:    map[string]string{lssv1alpha1.ServiceTargetConfigVisibleLabelName: "true"},
+				},
+				Spec: lssv1alpha1.ServiceTargetConfigSpec{Priority: 10},
+			},
+		}
+		deployment := &lssv1alpha1.LandscaperDeployment{
+			Spec: lssv1alpha1.LandscaperDeploymentSpec{TenantId: tenant1},
+		}
+
+		winner, decision, err := lssscheduling.Schedule(merged.Scheduling, deployment, serviceTargetConfigs, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(winner.Name).To(Equal(config2))
+
+		merged.ResolveMatchedRules(decision)
+		Expect(decision.MatchedRules).To(Equal([]lssv1alpha1.MatchedSchedulingRule{
+			{
+				Scheduling: &lssv1alpha1.ObjectReference{Name: "b", Namespace: namespace2},
+				Index:      0,
+				Priority:   20,
+				Applied:    true,
+			},
+		}))
+	})
+
+	It("should determine whether a target scheduling is active", func() {
+		scheduling := buildTargetScheduling("a", namespace1)
+
+		active, err := lssscheduling.IsActiveScheduling(&scheduling, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeFalse())
+
+		active, err = lssscheduling.IsActiveScheduling(&scheduling, &lsv1alpha1.ObjectReference{Name: "a", Namespace: namespace1}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeTrue())
+
+		active, err = lssscheduling.IsActiveScheduling(&scheduling, &lsv1alpha1.ObjectReference{Name: "b", Namespace: namespace1},
+			&metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeTrue())
+
+		active, err = lssscheduling.IsActiveScheduling(&scheduling, nil,
+			&metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeFalse())
+	})
+})
@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// PlanRebalance evaluates the placement of the given instances against the scheduling rules and the priorities of the
+// ServiceTargetConfigs, and proposes migrations which improve the distribution of the instances.
+// Every instance is scheduled again as if it was not yet deployed. A migration is proposed if its ServiceTargetConfig
+// is not a candidate for its deployment anymore, or if the selected ServiceTargetConfig has a higher score.
+// Instances on cordoned or not ready ServiceTargetConfigs are left to the drain operation, as well as instances on
+// ServiceTargetConfigs which are not contained in the given list.
+// The proposed migrations are taken into account when the subsequent instances are evaluated.
+// If sources is not empty, only instances deployed on these ServiceTargetConfigs are evaluated.
+// If maxMoves is not nil, at most maxMoves migrations are proposed.
+// The passed objects are not modified.
+func PlanRebalance(
+	scheduling *lssv1alpha1.TargetScheduling,
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	deployments []lssv1alpha1.LandscaperDeployment,
+	instances []lssv1alpha1.Instance,
+	sources []lssv1alpha1.ObjectReference,
+	maxMoves *int) []lssv1alpha1.RebalanceMove {
+
+	configs := make([]lssv1alpha1.ServiceTargetConfig, 0, len(serviceTargetConfigs))
+	for i := range serviceTargetConfigs {
+		configs = append(configs, *serviceTargetConfigs[i].DeepCopy())
+	}
+
+	placed := make([]lssv1alpha1.Instance, 0, len(instances))
+	for i := range instances {
+		placed = append(placed, *instances[i].DeepCopy())
+	}
+	sort.SliceStable(placed, func(i, j int) bool {
+		return client.ObjectKeyFromObject(&placed[i]).String() < client.ObjectKeyFromObject(&placed[j]).String()
+	})
+
+	deploymentsByKey := make(map[types.NamespacedName]*lssv1alpha1.LandscaperDeployment, len(deployments))
+	for i := range deployments {
+		deploymentsByKey[client.ObjectKeyFromObject(&deployments[i])] = &deployments[i]
+	}
+
+	moves := make([]lssv1alpha1.RebalanceMove, 0)
+
+	for i := range placed {
+		if maxMoves != nil && len(moves) >= *maxMoves {
+			break
+		}
+
+		instance := &placed[i]
+		source := instance.Spec.ServiceTargetConfigRef
+		if source.IsEmpty() || instance.IsMigrating() || utils.HasOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationIgnore) {
+			continue
+		}
+		if len(sources) > 0 && !utils.ContainsReference(sources, &source) {
+			continue
+		}
+
+		deployment := owningDeployment(instance, deploymentsByKey)
+		if deployment == nil || !deployment.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		current := findServiceTargetConfig(configs, &source)
+		if current == nil || current.Spec.Cordoned {
+			continue
+		}
+
+		// the instance is scheduled again as if it was not yet deployed
+		instanceRef := lssv1alpha1.ObjectReference{Name: instance.Name, Namespace: instance.Namespace}
+		current.Status.InstanceRefs = removeReference(current.Status.InstanceRefs, &instanceRef)
+		others := slices.Concat(placed[:i], placed[i+1:])
+
+		winner, decision, err := Schedule(scheduling, deployment, configs, others)
+
+		reason := ""
+		if err == nil && !source.IsObject(winner) {
+			reason = rebalanceReason(decision, &source, current, winner)
+		}
+
+		if len(reason) == 0 {
+			current.Status.InstanceRefs = append(current.Status.InstanceRefs, instanceRef)
+			continue
+		}
+
+		destination := lssv1alpha1.ObjectReference{Name: winner.Name, Namespace: winner.Namespace}
+		winner.Status.InstanceRefs = append(winner.Status.InstanceRefs, instanceRef)
+		instance.Spec.ServiceTargetConfigRef = destination
+
+		moves = append(moves, lssv1alpha1.RebalanceMove{
+			Instance:    instanceRef,
+			Deployment:  lssv1alpha1.ObjectReference{Name: deployment.Name, Namespace: deployment.Namespace},
+			TenantID:    instance.Spec.TenantId,
+			Source:      source,
+			Destination: destination,
+			Reason:      reason,
+			Phase:       lssv1alpha1.RebalanceMovePhaseProposed,
+		})
+	}
+
+	return moves
+}
+
+// CheckRebalanceDestination evaluates right before a proposed migration is started, whether its destination is still an
+// eligible candidate for the deployment of the instance. Like in PlanRebalance, the instance is scheduled again as if it
+// was not yet deployed, with the current scheduling rules, ServiceTargetConfigs and instances.
+// The instance references of the ServiceTargetConfigs are completed with the instances which are scheduled on them,
+// but are not yet recorded in their status, e.g. because a migration to the ServiceTargetConfig has just been started.
+// Returns why the destination is not eligible anymore, or an empty string if it is.
+// The passed objects are not modified.
+func CheckRebalanceDestination(
+	scheduling *lssv1alpha1.TargetScheduling,
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	instances []lssv1alpha1.Instance,
+	instance *lssv1alpha1.Instance,
+	destination *lssv1alpha1.ObjectReference) string {
+
+	instanceRef := lssv1alpha1.ObjectReference{Name: instance.Name, Namespace: instance.Namespace}
+
+	configs := make([]lssv1alpha1.ServiceTargetConfig, 0, len(serviceTargetConfigs))
+	for i := range serviceTargetConfigs {
+		config := serviceTargetConfigs[i].DeepCopy()
+		config.Status.InstanceRefs = removeReference(config.Status.InstanceRefs, &instanceRef)
+		configs = append(configs, *config)
+	}
+
+	others := make([]lssv1alpha1.Instance, 0, len(instances))
+	for i := range instances {
+		other := &instances[i]
+		otherRef := lssv1alpha1.ObjectReference{Name: other.Name, Namespace: other.Namespace}
+		if otherRef.Equals(&instanceRef) {
+			continue
+		}
+		others = append(others, *other)
+
+		config := findServiceTargetConfig(configs, &other.Spec.ServiceTargetConfigRef)
+		if config != nil && !utils.ContainsReference(config.Status.InstanceRefs, &otherRef) {
+			config.Status.InstanceRefs = append(config.Status.InstanceRefs, otherRef)
+		}
+	}
+
+	_, decision, _ := Schedule(scheduling, deployment, configs, others)
+	for _, candidate := range decision.Candidates {
+		if !candidate.ServiceTargetConfig.Equals(destination) {
+			continue
+		}
+		if len(candidate.FilterReason) > 0 {
+			return fmt.Sprintf("destination service target config is filtered for the deployment: %s", candidate.FilterReason)
+		}
+		return ""
+	}
+
+	return "destination service target config is not a candidate for the deployment anymore"
+}
+
+// rebalanceReason returns why an instance should be migrated from its current ServiceTargetConfig to the selected one,
+// or an empty string if the instance should stay where it is.
+func rebalanceReason(
+	decision *lssv1alpha1.SchedulingDecision,
+	source *lssv1alpha1.ObjectReference,
+	current *lssv1alpha1.ServiceTargetConfig,
+	winner *lssv1alpha1.ServiceTargetConfig,
+) string {
+
+	var candidate *lssv1alpha1.SchedulingCandidate
+	for i := range decision.Candidates {
+		if decision.Candidates[i].ServiceTargetConfig.Equals(source) {
+			candidate = &decision.Candidates[i]
+			break
+		}
+	}
+
+	if candidate == nil {
+		return fmt.Sprintf("service target config %s is not a candidate for the deployment anymore", source.NamespacedName().String())
+	}
+
+	switch candidate.FilterReason {
+	case FilterReasonCordoned, FilterReasonNotReady:
+		// moving instances away from these service target configs is the task of the drain operation
+		return ""
+	case "":
+	default:
+		return fmt.Sprintf("service target config %s is filtered for the deployment: %s", source.NamespacedName().String(), candidate.FilterReason)
+	}
+
+	// Only a strictly higher score justifies a migration, so that instances do not move between equally ranked service target configs.
+	currentCount := len(current.Status.InstanceRefs)
+	winnerCount := len(winner.Status.InstanceRefs)
+	if winner.Spec.Priority*int64(currentCount+1) <= current.Spec.Priority*int64(winnerCount+1) {
+		return ""
+	}
+
+	return fmt.Sprintf("service target config %s has a higher score (%s) than service target config %s (%s)",
+		client.ObjectKeyFromObject(winner).String(), formatScore(winner.Spec.Priority, winnerCount),
+		source.NamespacedName().String(), formatScore(current.Spec.Priority, currentCount))
+}
+
+// owningDeployment returns the deployment which owns the instance, or nil if there is no such deployment.
+func owningDeployment(instance *lssv1alpha1.Instance, deployments map[types.NamespacedName]*lssv1alpha1.LandscaperDeployment) *lssv1alpha1.LandscaperDeployment {
+	ownerRef := metav1.GetControllerOf(instance)
+	if ownerRef == nil || ownerRef.Kind != "LandscaperDeployment" {
+		return nil
+	}
+	return deployments[types.NamespacedName{Name: ownerRef.Name, Namespace: instance.Namespace}]
+}
+
+// findServiceTargetConfig returns the referenced ServiceTargetConfig, or nil if it is not contained in the list.
+func findServiceTargetConfig(configs []lssv1alpha1.ServiceTargetConfig, ref *lssv1alpha1.ObjectReference) *lssv1alpha1.ServiceTargetConfig {
+	for i := range configs {
+		if ref.IsObject(&configs[i]) {
+			return &configs[i]
+		}
+	}
+	return nil
+}
+
+// removeReference returns a copy of the references without the given one.
+func removeReference(refs []lssv1alpha1.ObjectReference, ref *lssv1alpha1.ObjectReference) []lssv1alpha1.ObjectReference {
+	result := make([]lssv1alpha1.ObjectReference, 0, len(refs))
+	for i := range refs {
+		if !refs[i].Equals(ref) {
+			result = append(result, refs[i])
+		}
+	}
+	return result
+}
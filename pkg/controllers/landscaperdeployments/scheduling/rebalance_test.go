@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+)
+
+var _ = Describe("Rebalance", func() {
+
+	const (
+		namespace1 = "test-namespace-1"
+
+		config1 = "test-config-1"
+		config2 = "test-config-2"
+
+		tenant1 = "test-tenant-1"
+	)
+
+	var (
+		serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig
+		deployments          []lssv1alpha1.LandscaperDeployment
+		instances            []lssv1alpha1.Instance
+	)
+
+	buildServiceTargetConfig := func(name string, prio int64) lssv1alpha1.ServiceTargetConfig {
+		return lssv1alpha1.ServiceTargetConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace1,
+			},
+			Spec: lssv1alpha1.ServiceTargetConfigSpec{
+				Priority: prio,
+			},
+		}
+	}
+
+	// addInstance adds an instance together with its landscaper deployment and its reference in the service target config.
+	addInstance := func(name string, config *lssv1alpha1.ServiceTargetConfig) {
+		instanceRef := lssv1alpha1.ObjectReference{Name: name, Namespace: namespace1}
+
+		deployment := lssv1alpha1.LandscaperDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace1,
+			},
+			Spec: lssv1alpha1.LandscaperDeploymentSpec{
+				TenantId: tenant1,
+			},
+			Status: lssv1alpha1.LandscaperDeploymentStatus{
+				InstanceRef: &instanceRef,
+			},
+		}
+
+		instance := lssv1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace1,
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: lssv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "LandscaperDeployment",
+						Name:       name,
+						Controller: ptr.To(true),
+					},
+				},
+			},
+			Spec: lssv1alpha1.InstanceSpec{
+				TenantId: tenant1,
+				ServiceTargetConfigRef: lssv1alpha1.ObjectReference{
+					Name:      config.Name,
+					Namespace: config.Namespace,
+				},
+			},
+		}
+
+		deployments = append(deployments, deployment)
+		instances = append(instances, instance)
+		config.Status.InstanceRefs = append(config.Status.InstanceRefs, instanceRef)
+	}
+
+	BeforeEach(func() {
+		serviceTargetConfigs = []lssv1alpha1.ServiceTargetConfig{
+			buildServiceTargetConfig(config1, 10),
+			buildServiceTargetConfig(config2, 10),
+		}
+		deployments = nil
+		instances = nil
+
+		for i := 1; i <= 4; i++ {
+			addInstance(fmt.Sprintf("instance-%d", i), &serviceTargetConfigs[0])
+		}
+	})
+
+	It("should propose moves until the instances are balanced", func() {
+		moves := lssscheduling.PlanRebalance(nil, serviceTargetConfigs, deployments, instances, nil, nil)
+		Expect(moves).To(HaveLen(2))
+
+		for i, move := range moves {
+			Expect(move.Instance.Name).To(Equal(fmt.Sprintf("instance-%d", i+1)))
+			Expect(move.Deployment).To(Equal(move.Instance))
+			Expect(move.TenantID).To(Equal(tenant1))
+			Expect(move.Source.Name).To(Equal(config1))
+			Expect(move.Destination.Name).To(Equal(config2))
+			Expect(move.Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseProposed))
+		}
+		Expect(moves[0].Reason).To(ContainSubstring("has a higher score (10.00) than service target config test-namespace-1/test-config-1 (2.50)"))
+
+		// the passed objects are not modified
+		Expect(serviceTargetConfigs[0].Status.InstanceRefs).To(HaveLen(4))
+		Expect(serviceTargetConfigs[1].Status.InstanceRefs).To(BeEmpty())
+		Expect(instances[0].Spec.ServiceTargetConfigRef.Name).To(Equal(config1))
+	})
+
+	It("should not propose more moves than allowed", func() {
+		moves := lssscheduling.PlanRebalance(nil, serviceTargetConfigs, deployments, instances, nil, ptr.To(1))
+		Expect(moves).To(HaveLen(1))
+	})
+
+	It("should only evaluate the instances on the given service target configs", func() {
+		sources := []lssv1alpha1.ObjectReference{{Name: config2, Namespace: namespace1}}
+		moves := lssscheduling.PlanRebalance(nil, serviceTargetConfigs, deployments, instances, sources, nil)
+		Expect(moves).To(BeEmpty())
+	})
+
+	It("should skip instances which are being migrated or ignored", func() {
+		instances[0].Status.ServiceTargetConfigRef = &lssv1alpha1.ObjectReference{Name: config2, Namespace: namespace1}
+		instances[1].Annotations = map[string]string{
+			lssv1alpha1.LandscaperServiceOperationAnnotation: lssv1alpha1.LandscaperServiceOperationIgnore,
+		}
+
+		moves := lssscheduling.PlanRebalance(nil, serviceTargetConfigs, deployments, instances, nil, nil)
+		Expect(moves).To(HaveLen(2))
+		Expect(moves[0].Instance.Name).To(Equal("instance-3"))
+		Expect(moves[1].Instance.Name).To(Equal("instance-4"))
+	})
+
+	It("should move the instances away from service target configs which are not a candidate anymore", func() {
+		scheduling := &lssv1alpha1.TargetScheduling{
+			Spec: lssv1alpha1.TargetSchedulingSpec{
+				Rules: []lssv1alpha1.SchedulingRule{
+					{
+						Priority: 1,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config2, Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+						},
+					},
+				},
+			},
+		}
+
+		moves := lssscheduling.PlanRebalance(scheduling, serviceTargetConfigs, deployments, instances, nil, nil)
+		Expect(moves).To(HaveLen(4))
+		Expect(moves[3].Reason).To(ContainSubstring("is not a candidate for the deployment anymore"))
+	})
+
+	It("should leave the instances on cordoned service target configs to the drain", func() {
+		serviceTargetConfigs[0].Spec.Cordoned = true
+
+		moves := lssscheduling.PlanRebalance(nil, serviceTargetConfigs, deployments, instances, nil, nil)
+		Expect(moves).To(BeEmpty())
+	})
+
+	It("should leave the instances on service target configs which are not listed", func() {
+		moves := lssscheduling.PlanRebalance(nil, serviceTargetConfigs[1:], deployments, instances, nil, nil)
+		Expect(moves).To(BeEmpty())
+	})
+
+	It("should not move instances between equally ranked service target configs", func() {
+		addInstance("instance-5", &serviceTargetConfigs[1])
+		addInstance("instance-6", &serviceTargetConfigs[1])
+		addInstance("instance-7", &serviceTargetConfigs[1])
+
+		moves := lssscheduling.PlanRebalance(nil, serviceTargetConfigs, deployments, instances, nil, nil)
+		Expect(moves).To(BeEmpty())
+	})
+
+	It("should accept a destination which is still a candidate for the deployment", func() {
+		destination := lssv1alpha1.ObjectReference{Name: config2, Namespace: namespace1}
+
+		reason := lssscheduling.CheckRebalanceDestination(nil, serviceTargetConfigs, &deployments[0], instances, &instances[0], &destination)
+		Expect(reason).To(BeEmpty())
+	})
+
+	It("should count the instances which have just been moved to the destination", func() {
+		serviceTargetConfigs[1].Spec.MaxInstances = ptr.To[int64](1)
+		destination := lssv1alpha1.ObjectReference{Name: config2, Namespace: namespace1}
+
+		// the migration of another instance has been started, but is not yet recorded in the service target config
+		instances[1].Spec.ServiceTargetConfigRef = destination
+
+		reason := lssscheduling.CheckRebalanceDestination(nil, serviceTargetConfigs, &deployments[0], instances, &instances[0], &destination)
+		Expect(reason).To(ContainSubstring("filtered"))
+		Expect(serviceTargetConfigs[1].Status.InstanceRefs).To(BeEmpty())
+	})
+
+	It("should reject a destination which is not a candidate anymore", func() {
+		serviceTargetConfigs[1].Spec.Cordoned = true
+		destination := lssv1alpha1.ObjectReference{Name: config2, Namespace: namespace1}
+
+		reason := lssscheduling.CheckRebalanceDestination(nil, serviceTargetConfigs, &deployments[0], instances, &instances[0], &destination)
+		Expect(reason).ToNot(BeEmpty())
+	})
+})
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// GetVisibleServiceTargetConfigs returns the ServiceTargetConfigs which are available for the scheduling of new instances.
+// These are the ServiceTargetConfigs with the visible label, which are not cordoned.
+func GetVisibleServiceTargetConfigs(ctx context.Context, c client.Reader) ([]lssv1alpha1.ServiceTargetConfig, error) {
+	serviceTargetConfigs, err := ListVisibleServiceTargetConfigs(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]lssv1alpha1.ServiceTargetConfig, 0, len(serviceTargetConfigs))
+	for i := range serviceTargetConfigs {
+		if !serviceTargetConfigs[i].Spec.Cordoned {
+			result = append(result, serviceTargetConfigs[i])
+		}
+	}
+
+	return result, nil
+}
+
+// ListVisibleServiceTargetConfigs returns the ServiceTargetConfigs with the visible label, including the cordoned ones.
+func ListVisibleServiceTargetConfigs(ctx context.Context, c client.Reader) ([]lssv1alpha1.ServiceTargetConfig, error) {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+
+	serviceTargetConfigList := &lssv1alpha1.ServiceTargetConfigList{}
+	if err := c.List(ctx, serviceTargetConfigList, client.MatchingLabels{lssv1alpha1.ServiceTargetConfigVisibleLabelName: "true"}); err != nil {
+		log.Error(err, "unable to list service target configs")
+		return nil, fmt.Errorf("unable to list service target configs: %w", err)
+	}
+
+	return serviceTargetConfigList.Items, nil
+}
+
+// GetSchedulingResource returns the TargetScheduling resource referenced by the given object reference.
+// Returns nil if scheduling is not configured or the scheduling resource does not exist.
+func GetSchedulingResource(ctx context.Context, c client.Reader, schedulingRef *lsv1alpha1.ObjectReference) (*lssv1alpha1.TargetScheduling, error) {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+
+	if schedulingRef == nil {
+		log.Info("no scheduling configured")
+		return nil, nil
+	}
+
+	schedulingKey := schedulingRef.NamespacedName()
+	scheduling := &lssv1alpha1.TargetScheduling{}
+	if err := c.Get(ctx, schedulingKey, scheduling); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("no scheduling resource configured")
+			return nil, nil
+		}
+
+		log.Error(err, "unable to get scheduling object", lc.KeyResource, schedulingKey.String())
+		return nil, fmt.Errorf("unable to get scheduling object %s: %w", schedulingKey.String(), err)
+	}
+
+	return scheduling, nil
+}
+
+// GetSchedulingResources returns the TargetScheduling resource referenced by the given object reference
+// and all TargetScheduling resources in all namespaces, which match the given label selector.
+// The referenced TargetScheduling comes first, followed by the selected ones sorted by namespace and name.
+// Returns an empty list if scheduling is not configured or no scheduling resource exists.
+func GetSchedulingResources(ctx context.Context, c client.Reader, schedulingRef *lsv1alpha1.ObjectReference,
+	schedulingSelector *metav1.LabelSelector) ([]lssv1alpha1.TargetScheduling, error) {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+
+	result := make([]lssv1alpha1.TargetScheduling, 0)
+
+	scheduling, err := GetSchedulingResource(ctx, c, schedulingRef)
+	if err != nil {
+		return nil, err
+	}
+	if scheduling != nil {
+		result = append(result, *scheduling)
+	}
+
+	if schedulingSelector == nil {
+		return result, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(schedulingSelector)
+	if err != nil {
+		log.Error(err, "invalid scheduling selector")
+		return nil, fmt.Errorf("invalid scheduling selector: %w", err)
+	}
+
+	schedulingList := &lssv1alpha1.TargetSchedulingList{}
+	if err := c.List(ctx, schedulingList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		log.Error(err, "unable to list scheduling objects")
+		return nil, fmt.Errorf("unable to list scheduling objects: %w", err)
+	}
+
+	selected := schedulingList.Items
+	sort.SliceStable(selected, func(i, j int) bool {
+		if selected[i].Namespace != selected[j].Namespace {
+			return selected[i].Namespace < selected[j].Namespace
+		}
+		return selected[i].Name < selected[j].Name
+	})
+
+	for i := range selected {
+		if scheduling != nil && client.ObjectKeyFromObject(&selected[i]) == client.ObjectKeyFromObject(scheduling) {
+			continue
+		}
+		result = append(result, selected[i])
+	}
+
+	return result, nil
+}
+
+// GetMergedScheduling returns the merged rules of the TargetScheduling resources returned by GetSchedulingResources.
+func GetMergedScheduling(ctx context.Context, c client.Reader, schedulingRef *lsv1alpha1.ObjectReference,
+	schedulingSelector *metav1.LabelSelector) (*MergedScheduling, error) {
+
+	schedulings, err := GetSchedulingResources(ctx, c, schedulingRef, schedulingSelector)
+	if err != nil {
+		return nil, err
+	}
+	return MergeTargetSchedulings(schedulings), nil
+}
+
+// IsActiveScheduling returns whether the rules of the given TargetScheduling are used for the scheduling,
+// i.e. whether it is referenced by the given object reference or matches the given label selector.
+func IsActiveScheduling(scheduling *lssv1alpha1.TargetScheduling, schedulingRef *lsv1alpha1.ObjectReference,
+	schedulingSelector *metav1.LabelSelector) (bool, error) {
+
+	if schedulingRef != nil && schedulingRef.NamespacedName() == (types.NamespacedName{Name: scheduling.Name, Namespace: scheduling.Namespace}) {
+		return true, nil
+	}
+
+	if schedulingSelector == nil {
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(schedulingSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid scheduling selector: %w", err)
+	}
+	return selector.Matches(labels.Set(scheduling.GetLabels())), nil
+}
@@ -5,70 +5,196 @@
 package scheduling
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
+// ErrNoCapacity is returned by FindServiceTargetConfig if there are candidate ServiceTargetConfigs for a deployment,
+// but all of them have reached their capacity limit.
+var ErrNoCapacity = errors.New("no capacity")
+
+const (
+	// FilterReasonNotAvailable is the filter reason of a candidate that does not exist or is not visible.
+	FilterReasonNotAvailable = "NotAvailable"
+	// FilterReasonCordoned is the filter reason of a candidate that is cordoned.
+	FilterReasonCordoned = "Cordoned"
+	// FilterReasonNotReady is the filter reason of a candidate whose Ready condition has status False.
+	FilterReasonNotReady = "NotReady"
+	// FilterReasonMaxInstancesReached is the filter reason of a candidate that has reached its maximum number of instances.
+	FilterReasonMaxInstancesReached = "MaxInstancesReached"
+	// FilterReasonMaxInstancesPerTenantReached is the filter reason of a candidate that has reached its maximum number of instances
+	// for the tenant of the deployment.
+	FilterReasonMaxInstancesPerTenantReached = "MaxInstancesPerTenantReached"
+	// FilterReasonRegionMismatch is the filter reason of a candidate that is not in the region requested by the deployment.
+	FilterReasonRegionMismatch = "RegionMismatch"
+)
+
+// FindServiceTargetConfig determines the ServiceTargetConfig on which the instance of the given deployment is scheduled.
+// The instances are the already existing instances. They are used to compute the usage of a ServiceTargetConfig per tenant,
+// and to evaluate the spread constraints and anti-affinity terms of the deployment.
 func FindServiceTargetConfig(
 	scheduling *lssv1alpha1.TargetScheduling,
 	deployment *lssv1alpha1.LandscaperDeployment,
-	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig) (*lssv1alpha1.ServiceTargetConfig, error) {
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	instances []lssv1alpha1.Instance) (*lssv1alpha1.ServiceTargetConfig, error) {
+
+	winner, _, err := Schedule(scheduling, deployment, serviceTargetConfigs, instances)
+	return winner, err
+}
+
+// Schedule determines the ServiceTargetConfig like FindServiceTargetConfig.
+// Additionally, it returns a record of the scheduling decision. The record is also returned if the scheduling fails.
+func Schedule(
+	scheduling *lssv1alpha1.TargetScheduling,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	instances []lssv1alpha1.Instance) (*lssv1alpha1.ServiceTargetConfig, *lssv1alpha1.SchedulingDecision, error) {
+
+	decision := &lssv1alpha1.SchedulingDecision{
+		Time: metav1.Now(),
+	}
+
+	fail := func(err error) (*lssv1alpha1.ServiceTargetConfig, *lssv1alpha1.SchedulingDecision, error) {
+		decision.Message = err.Error()
+		return nil, decision, err
+	}
 
 	// Find the ServiceTargetConfigs which match the deployment according to the scheduling rules.
 	configRefs := make([]lssv1alpha1.ObjectReference, 0)
 	if scheduling != nil {
 		var err error
-		configRefs, err = evaluateRules(scheduling, deployment)
+		configRefs, decision.MatchedRules, err = evaluateRules(scheduling, deployment)
 		if err != nil {
-			return nil, err
+			return fail(err)
 		}
 	}
 
 	// If scheduling is not configured, or no scheduling rules match, there are no configRefs so far.
 	// In this case, we continue with the unrestricted ServiceTargetConfigs.
-	if len(configRefs) == 0 {
+	usesRules := len(configRefs) > 0
+	if !usesRules {
 		configRefs = getUnrestricted(serviceTargetConfigs)
 	}
 
 	// Remove duplicates and not existing ServiceTargetConfigs.
-	configs := convertAndFilter(configRefs, serviceTargetConfigs)
+	configs, notAvailable := convertAndFilter(configRefs, serviceTargetConfigs)
+	filtered := make([]lssv1alpha1.SchedulingCandidate, 0)
+	for _, ref := range notAvailable {
+		filtered = append(filtered, lssv1alpha1.SchedulingCandidate{
+			ServiceTargetConfig: ref,
+			FilterReason:        FilterReasonNotAvailable,
+		})
+	}
+
+	// Remove the ServiceTargetConfigs which are not in the region requested by the deployment.
+	configs, filteredByRegion := filterByRegion(configs, deployment)
+	filtered = append(filtered, filteredByRegion...)
+
+	// Report the requested region only if it is the reason why no candidate is left.
+	if len(configs) == 0 && len(filteredByRegion) > 0 {
+		decision.Candidates = filtered
+		return fail(fmt.Errorf("no service target config available in region %q", deployment.Spec.Region))
+	}
+
+	// Remove the cordoned ServiceTargetConfigs and those whose target cluster is not ready.
+	configs, unschedulable := filterUnschedulable(configs)
+	filtered = append(filtered, unschedulable...)
+
+	if len(configs) == 0 {
+		decision.Candidates = filtered
+		return fail(fmt.Errorf("no service target config available"))
+	}
+
+	// Remove the ServiceTargetConfigs which have reached their capacity limit.
+	configs, filteredByCapacity := filterByCapacity(configs, deployment, instances)
+	filtered = append(filtered, filteredByCapacity...)
+
 	if len(configs) == 0 {
-		err := fmt.Errorf("no service target config available")
-		return nil, err
+		decision.Candidates = filtered
+		return fail(fmt.Errorf("%w: all service target configs available for tenant %q have reached their capacity limit",
+			ErrNoCapacity, deployment.Spec.TenantId))
 	}
 
-	// Pick one of the ServiceTargetConfigs.
-	return PickServiceTargetConfig(configs)
+	// Remove the ServiceTargetConfigs which violate the spread constraints or anti-affinity terms of the deployment.
+	constraints := collectConstraints(scheduling, decision.MatchedRules, deployment)
+	configs, filteredByConstraints, penalties := filterByConstraints(configs, constraints, deployment, serviceTargetConfigs, instances)
+	filtered = append(filtered, filteredByConstraints...)
+
+	if len(configs) == 0 {
+		decision.Candidates = filtered
+		return fail(fmt.Errorf("no service target config satisfies the scheduling constraints of the deployment"))
+	}
+
+	// Pick one of the ServiceTargetConfigs, preferring those which violate the least soft constraints.
+	winner, err := PickServiceTargetConfig(configs)
+	if err != nil {
+		decision.Candidates = filtered
+		return fail(err)
+	}
+	if len(penalties) > 0 {
+		sortByPenalty(configs, penalties)
+		winner = configs[0]
+	}
+
+	// PickServiceTargetConfig has sorted the configs, so that the eligible candidates are recorded in the order of their rank.
+	for _, config := range configs {
+		decision.Candidates = append(decision.Candidates, newSchedulingCandidate(config, ""))
+	}
+	decision.Candidates = append(decision.Candidates, filtered...)
+
+	decision.Selected = &lssv1alpha1.ObjectReference{
+		Name:      winner.Name,
+		Namespace: winner.Namespace,
+	}
+	if usesRules {
+		decision.Message = fmt.Sprintf("selected service target config %s by scheduling rules", decision.Selected.NamespacedName().String())
+	} else {
+		decision.Message = fmt.Sprintf("selected service target config %s from unrestricted service target configs", decision.Selected.NamespacedName().String())
+	}
+
+	return winner, decision, nil
 }
 
+// evaluateRules returns the ServiceTargetConfigs of the matching rules with the highest priority,
+// together with a record of all matching rules.
+// A selector which can't be evaluated fails the scheduling, unless a rule with a higher priority matches.
+// In that case, the error is recorded for the rule instead, because the rule couldn't have been applied anyway.
 func evaluateRules(
 	scheduling *lssv1alpha1.TargetScheduling,
 	deployment *lssv1alpha1.LandscaperDeployment,
-) ([]lssv1alpha1.ObjectReference, error) {
+) ([]lssv1alpha1.ObjectReference, []lssv1alpha1.MatchedSchedulingRule, error) {
 
 	var highestFoundPrio int64 = -1
 	candidates := make([]lssv1alpha1.ObjectReference, 0)
+	matchedRules := make([]lssv1alpha1.MatchedSchedulingRule, 0)
+	selectorErrs := make(map[int]error)
 
 	for i := range scheduling.Spec.Rules {
 		rule := &scheduling.Spec.Rules[i]
 
 		if len(rule.ServiceTargetConfigs) == 0 {
-			return nil, fmt.Errorf("rule must contain at least one service target config")
+			return nil, nil, fmt.Errorf("rule must contain at least one service target config")
 		}
 		if rule.Priority < 0 {
-			return nil, fmt.Errorf("rule priority must not be negative")
-		}
-
-		if rule.Priority < highestFoundPrio {
-			// we have already found a candidate with a higher prio
-			continue
+			return nil, nil, fmt.Errorf("rule priority must not be negative")
 		}
 
 		match, err := EvaluateSelectorList(rule.Selector, deployment)
 		if err != nil {
-			return nil, err
+			selectorErrs[len(matchedRules)] = err
+			matchedRules = append(matchedRules, lssv1alpha1.MatchedSchedulingRule{
+				Index:    i,
+				Priority: rule.Priority,
+				Error:    err.Error(),
+			})
+			continue
 		}
 
 		if !match {
@@ -76,6 +202,16 @@ func evaluateRules(
 			continue
 		}
 
+		matchedRules = append(matchedRules, lssv1alpha1.MatchedSchedulingRule{
+			Index:    i,
+			Priority: rule.Priority,
+		})
+
+		if rule.Priority < highestFoundPrio {
+			// we have already found a candidate with a higher prio
+			continue
+		}
+
 		if rule.Priority > highestFoundPrio {
 			// rule has higher prio: replace candidates
 			highestFoundPrio = rule.Priority
@@ -86,7 +222,18 @@ func evaluateRules(
 		}
 	}
 
-	return candidates, nil
+	for i := range matchedRules {
+		if err, ok := selectorErrs[i]; ok {
+			if matchedRules[i].Priority >= highestFoundPrio {
+				// the rule could have been applied, if its selector had matched
+				return nil, nil, err
+			}
+			continue
+		}
+		matchedRules[i].Applied = matchedRules[i].Priority == highestFoundPrio
+	}
+
+	return candidates, matchedRules, nil
 }
 
 func getUnrestricted(serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig) []lssv1alpha1.ObjectReference {
@@ -108,21 +255,148 @@ func getUnrestricted(serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig) []l
 
 // convertAndFilter converts ObjectReferences to ServiceTargetConfigs.
 // It skips duplicates and ObjectReferences for which there exists no ServiceTargetConfig.
+// The skipped ObjectReferences for which there exists no ServiceTargetConfig are returned as second result.
 func convertAndFilter(
 	configRefs []lssv1alpha1.ObjectReference,
 	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
-) []*lssv1alpha1.ServiceTargetConfig {
+) ([]*lssv1alpha1.ServiceTargetConfig, []lssv1alpha1.ObjectReference) {
 
 	m := map[lssv1alpha1.ObjectReference]*lssv1alpha1.ServiceTargetConfig{}
+	notAvailable := make([]lssv1alpha1.ObjectReference, 0)
 
 	for _, ref := range configRefs {
+		found := false
 		for k := range serviceTargetConfigs {
 			serviceTargetConfig := &serviceTargetConfigs[k]
 			if ref.Name == serviceTargetConfig.Name && ref.Namespace == serviceTargetConfig.Namespace {
 				m[ref] = serviceTargetConfig
+				found = true
 			}
 		}
+
+		if !found && !utils.ContainsReference(notAvailable, &ref) {
+			notAvailable = append(notAvailable, ref)
+		}
+	}
+
+	return utils.GetMapValues(m), notAvailable
+}
+
+// filterByRegion removes the ServiceTargetConfigs whose region differs from the region requested by the deployment.
+// If the deployment does not request a region, no ServiceTargetConfig is removed.
+// The removed ServiceTargetConfigs are returned as filtered candidates.
+func filterByRegion(
+	configs []*lssv1alpha1.ServiceTargetConfig,
+	deployment *lssv1alpha1.LandscaperDeployment,
+) ([]*lssv1alpha1.ServiceTargetConfig, []lssv1alpha1.SchedulingCandidate) {
+
+	if len(deployment.Spec.Region) == 0 {
+		return configs, nil
+	}
+
+	result := make([]*lssv1alpha1.ServiceTargetConfig, 0, len(configs))
+	filtered := make([]lssv1alpha1.SchedulingCandidate, 0)
+
+	for _, config := range configs {
+		if config.Spec.Region != deployment.Spec.Region {
+			filtered = append(filtered, newSchedulingCandidate(config, FilterReasonRegionMismatch))
+		} else {
+			result = append(result, config)
+		}
+	}
+
+	return result, filtered
+}
+
+// filterUnschedulable removes the cordoned ServiceTargetConfigs, and the ServiceTargetConfigs whose Ready condition has status False.
+// ServiceTargetConfigs whose target cluster has not yet been probed are not removed.
+// The removed ServiceTargetConfigs are returned as filtered candidates.
+func filterUnschedulable(configs []*lssv1alpha1.ServiceTargetConfig) ([]*lssv1alpha1.ServiceTargetConfig, []lssv1alpha1.SchedulingCandidate) {
+	result := make([]*lssv1alpha1.ServiceTargetConfig, 0, len(configs))
+	filtered := make([]lssv1alpha1.SchedulingCandidate, 0)
+
+	for _, config := range configs {
+		if config.Spec.Cordoned {
+			filtered = append(filtered, newSchedulingCandidate(config, FilterReasonCordoned))
+		} else if meta.IsStatusConditionFalse(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReady) {
+			filtered = append(filtered, newSchedulingCandidate(config, FilterReasonNotReady))
+		} else {
+			result = append(result, config)
+		}
+	}
+
+	return result, filtered
+}
+
+// filterByCapacity removes the ServiceTargetConfigs which have reached their maximum number of instances,
+// or their maximum number of instances for the tenant of the deployment.
+// The removed ServiceTargetConfigs are returned as filtered candidates.
+func filterByCapacity(
+	configs []*lssv1alpha1.ServiceTargetConfig,
+	deployment *lssv1alpha1.LandscaperDeployment,
+	instances []lssv1alpha1.Instance,
+) ([]*lssv1alpha1.ServiceTargetConfig, []lssv1alpha1.SchedulingCandidate) {
+
+	result := make([]*lssv1alpha1.ServiceTargetConfig, 0, len(configs))
+	filtered := make([]lssv1alpha1.SchedulingCandidate, 0)
+
+	for _, config := range configs {
+		if reason := checkCapacity(config, deployment.Spec.TenantId, instances); len(reason) > 0 {
+			filtered = append(filtered, newSchedulingCandidate(config, reason))
+		} else {
+			result = append(result, config)
+		}
+	}
+
+	return result, filtered
+}
+
+// checkCapacity checks whether another instance of the given tenant can be scheduled on the ServiceTargetConfig.
+// If not, the filter reason is returned, otherwise an empty string.
+func checkCapacity(config *lssv1alpha1.ServiceTargetConfig, tenantID string, instances []lssv1alpha1.Instance) string {
+	if config.Spec.MaxInstances != nil && int64(len(config.Status.InstanceRefs)) >= *config.Spec.MaxInstances {
+		return FilterReasonMaxInstancesReached
 	}
 
-	return utils.GetMapValues(m)
+	if config.Spec.MaxInstancesPerTenant != nil && countTenantInstances(config, tenantID, instances) >= *config.Spec.MaxInstancesPerTenant {
+		return FilterReasonMaxInstancesPerTenantReached
+	}
+
+	return ""
+}
+
+// countTenantInstances returns the number of instances of the given tenant which are scheduled on the ServiceTargetConfig.
+func countTenantInstances(config *lssv1alpha1.ServiceTargetConfig, tenantID string, instances []lssv1alpha1.Instance) int64 {
+	var count int64
+
+	for i := range instances {
+		instance := &instances[i]
+		if instance.Spec.TenantId == tenantID &&
+			instance.Spec.ServiceTargetConfigRef.Name == config.Name &&
+			instance.Spec.ServiceTargetConfigRef.Namespace == config.Namespace {
+			count++
+		}
+	}
+
+	return count
+}
+
+// newSchedulingCandidate creates the record of a candidate of a scheduling decision.
+func newSchedulingCandidate(config *lssv1alpha1.ServiceTargetConfig, filterReason string) lssv1alpha1.SchedulingCandidate {
+	instanceCount := len(config.Status.InstanceRefs)
+	return lssv1alpha1.SchedulingCandidate{
+		ServiceTargetConfig: lssv1alpha1.ObjectReference{
+			Name:      config.Name,
+			Namespace: config.Namespace,
+		},
+		Priority:      config.Spec.Priority,
+		InstanceCount: instanceCount,
+		Score:         formatScore(config.Spec.Priority, instanceCount),
+		FilterReason:  filterReason,
+	}
+}
+
+// formatScore formats the score of a ServiceTargetConfig with the given priority and number of instances.
+func formatScore(priority int64, instanceCount int) string {
+	return strconv.FormatFloat(float64(priority)/float64(instanceCount+1), 'f', 2, 64)
 }
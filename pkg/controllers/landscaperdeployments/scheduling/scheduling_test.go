@@ -5,9 +5,12 @@
 package scheduling_test
 
 import (
+	"errors"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
@@ -101,7 +104,7 @@ var _ = Describe("Scheduling", func() {
 			},
 		}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -156,7 +159,7 @@ var _ = Describe("Scheduling", func() {
 			},
 		}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -189,7 +192,7 @@ var _ = Describe("Scheduling", func() {
 			},
 		}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -225,7 +228,7 @@ var _ = Describe("Scheduling", func() {
 			},
 		}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -241,7 +244,7 @@ var _ = Describe("Scheduling", func() {
 
 		deployment := buildLandscaperDeployment(tenant1, nil)
 
-		config, err := lssscheduling.FindServiceTargetConfig(nil, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(nil, deployment, serviceTargetConfigs, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -274,7 +277,7 @@ var _ = Describe("Scheduling", func() {
 			},
 		}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
 	})
@@ -293,8 +296,318 @@ var _ = Describe("Scheduling", func() {
 
 		scheduling := &lssv1alpha1.TargetScheduling{}
 
-		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs)
+		config, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config2))
+	})
+
+	It("should not pick a cordoned service target config", func() {
+		// Two ServiceTargetConfigs match. The one with the higher prio is cordoned.
+		// Therefore, the other one should be selected, and the cordoned one should be recorded as filtered.
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			*buildServiceTargetConfig(config1, 100, false),
+			*buildServiceTargetConfig(config2, 1, false),
+		}
+		serviceTargetConfigs[0].Spec.Cordoned = true
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		config, decision, err := lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(config.Name).To(Equal(config2))
+		Expect(decision.Candidates).To(ContainElement(And(
+			HaveField("ServiceTargetConfig.Name", config1),
+			HaveField("FilterReason", lssscheduling.FilterReasonCordoned),
+		)))
+
+		serviceTargetConfigs[1].Spec.Cordoned = true
+		_, err = lssscheduling.FindServiceTargetConfig(nil, deployment, serviceTargetConfigs, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not pick a service target config which is not ready", func() {
+		// Three ServiceTargetConfigs match. The one with the highest prio is not ready.
+		// The one with the lowest prio has not yet been probed and is therefore still a candidate.
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			*buildServiceTargetConfig(config1, 100, false),
+			*buildServiceTargetConfig(config2, 10, false),
+			*buildServiceTargetConfig(config3, 1, false),
+		}
+		serviceTargetConfigs[0].Status.Conditions = []metav1.Condition{
+			{Type: lssv1alpha1.ServiceTargetConfigConditionReady, Status: metav1.ConditionFalse},
+		}
+		serviceTargetConfigs[1].Status.Conditions = []metav1.Condition{
+			{Type: lssv1alpha1.ServiceTargetConfigConditionReady, Status: metav1.ConditionTrue},
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		config, decision, err := lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config2))
+		Expect(decision.Candidates).To(ContainElement(And(
+			HaveField("ServiceTargetConfig.Name", config1),
+			HaveField("FilterReason", lssscheduling.FilterReasonNotReady),
+		)))
+		Expect(decision.Candidates).To(ContainElement(And(
+			HaveField("ServiceTargetConfig.Name", config3),
+			HaveField("FilterReason", ""),
+		)))
+	})
+
+	It("should not pick a service target config which has reached its maximum number of instances", func() {
+		// Two ServiceTargetConfigs match. The one with the higher prio is full.
+		// Therefore, the other one should be selected.
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			*buildServiceTargetConfig(config1, 100, false),
+			*buildServiceTargetConfig(config2, 1, false),
+		}
+		serviceTargetConfigs[0].Spec.MaxInstances = ptr.To[int64](1)
+		serviceTargetConfigs[0].Status.InstanceRefs = []lssv1alpha1.ObjectReference{
+			{Name: "instance-1", Namespace: namespace1},
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		config, err := lssscheduling.FindServiceTargetConfig(nil, deployment, serviceTargetConfigs, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config2))
+	})
+
+	It("should not pick a service target config which has reached its maximum number of instances per tenant", func() {
+		// Two ServiceTargetConfigs match. The one with the higher prio has reached the limit for tenant1, but not for tenant2.
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			*buildServiceTargetConfig(config1, 100, false),
+			*buildServiceTargetConfig(config2, 1, false),
+		}
+		serviceTargetConfigs[0].Spec.MaxInstancesPerTenant = ptr.To[int64](1)
+		serviceTargetConfigs[0].Status.InstanceRefs = []lssv1alpha1.ObjectReference{
+			{Name: "instance-1", Namespace: namespace1},
+		}
+
+		instances := []lssv1alpha1.Instance{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "instance-1", Namespace: namespace1},
+				Spec: lssv1alpha1.InstanceSpec{
+					TenantId:               tenant1,
+					ServiceTargetConfigRef: lssv1alpha1.ObjectReference{Name: config1, Namespace: namespace1},
+				},
+			},
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+		config, err := lssscheduling.FindServiceTargetConfig(nil, deployment, serviceTargetConfigs, instances)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config2))
+
+		deployment = buildLandscaperDeployment(tenant2, nil)
+		config, err = lssscheduling.FindServiceTargetConfig(nil, deployment, serviceTargetConfigs, instances)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config1))
+	})
+
+	It("should only pick a service target config in the region requested by the deployment", func() {
+		// Three ServiceTargetConfigs match. The one with the highest prio is in another region, the one with the lowest prio has no region.
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			*buildServiceTargetConfig(config1, 100, false),
+			*buildServiceTargetConfig(config2, 10, false),
+			*buildServiceTargetConfig(config3, 1, false),
+		}
+		serviceTargetConfigs[0].Spec.Region = "us-east-1"
+		serviceTargetConfigs[1].Spec.Region = "eu-west-1"
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+		config, err := lssscheduling.FindServiceTargetConfig(nil, deployment, serviceTargetConfigs, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config1))
+
+		deployment.Spec.Region = "eu-west-1"
+		config, decision, err := lssscheduling.Schedule(nil, deployment, serviceTargetConfigs, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config2))
+		Expect(decision.Candidates).To(ContainElements(
+			And(HaveField("ServiceTargetConfig.Name", config1), HaveField("FilterReason", lssscheduling.FilterReasonRegionMismatch)),
+			And(HaveField("ServiceTargetConfig.Name", config3), HaveField("FilterReason", lssscheduling.FilterReasonRegionMismatch)),
+		))
+
+		deployment.Spec.Region = "ap-south-1"
+		_, err = lssscheduling.FindServiceTargetConfig(nil, deployment, serviceTargetConfigs, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("no service target config available in region \"ap-south-1\""))
+	})
+
+	It("should not report a region if the deployment does not request one", func() {
+		deployment := buildLandscaperDeployment(tenant1, nil)
+		config, decision, err := lssscheduling.Schedule(nil, deployment, nil, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(config).To(BeNil())
+		Expect(err.Error()).To(Equal("no service target config available"))
+		Expect(decision.Message).To(Equal("no service target config available"))
+	})
+
+	It("should return a no capacity error if all matching service target configs are full", func() {
+		// The scheduling rule matches, but its only ServiceTargetConfig is full.
+		// The unrestricted ServiceTargetConfig must not be used as fallback.
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			*buildServiceTargetConfig(config1, 10, true),
+			*buildServiceTargetConfig(config2, 10, false),
+		}
+		serviceTargetConfigs[0].Spec.MaxInstances = ptr.To[int64](0)
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		scheduling := &lssv1alpha1.TargetScheduling{
+			Spec: lssv1alpha1.TargetSchedulingSpec{
+				Rules: []lssv1alpha1.SchedulingRule{
+					{
+						Priority: 4,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config1, Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+						},
+					},
+				},
+			},
+		}
+
+		_, err := lssscheduling.FindServiceTargetConfig(scheduling, deployment, serviceTargetConfigs, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, lssscheduling.ErrNoCapacity)).To(BeTrue())
+	})
+
+	It("should record the scheduling decision", func() {
+		// Two rules match, but only the one with the higher prio is applied.
+		// One of its ServiceTargetConfigs does not exist, one is full, and one remains.
+
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			*buildServiceTargetConfig(config1, 10, true),
+			*buildServiceTargetConfig(config2, 20, true),
+		}
+		serviceTargetConfigs[1].Spec.MaxInstances = ptr.To[int64](1)
+		serviceTargetConfigs[1].Status.InstanceRefs = []lssv1alpha1.ObjectReference{
+			{Name: "instance-1", Namespace: namespace1},
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		scheduling := &lssv1alpha1.TargetScheduling{
+			Spec: lssv1alpha1.TargetSchedulingSpec{
+				Rules: []lssv1alpha1.SchedulingRule{
+					{
+						Priority: 4,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config1, Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+						},
+					},
+					{
+						Priority: 8,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config1, Namespace: namespace1},
+							{Name: config2, Namespace: namespace1},
+							{Name: config3, Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+						},
+					},
+					{
+						Priority: 10,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config3, Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant2}},
+						},
+					},
+				},
+			},
+		}
+
+		config, decision, err := lssscheduling.Schedule(scheduling, deployment, serviceTargetConfigs, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config1))
+
+		Expect(decision).NotTo(BeNil())
+		Expect(decision.MatchedRules).To(Equal([]lssv1alpha1.MatchedSchedulingRule{
+			{Index: 0, Priority: 4, Applied: false},
+			{Index: 1, Priority: 8, Applied: true},
+		}))
+
+		Expect(decision.Selected).NotTo(BeNil())
+		Expect(decision.Selected.Name).To(Equal(config1))
+
+		Expect(decision.Candidates).To(HaveLen(3))
+		Expect(decision.Candidates[0].ServiceTargetConfig.Name).To(Equal(config1))
+		Expect(decision.Candidates[0].FilterReason).To(BeEmpty())
+		Expect(decision.Candidates[0].Score).To(Equal("10.00"))
+		Expect(decision.Candidates).To(ContainElement(SatisfyAll(
+			HaveField("ServiceTargetConfig.Name", config2),
+			HaveField("InstanceCount", 1),
+			HaveField("FilterReason", lssscheduling.FilterReasonMaxInstancesReached),
+		)))
+		Expect(decision.Candidates).To(ContainElement(SatisfyAll(
+			HaveField("ServiceTargetConfig.Name", config3),
+			HaveField("FilterReason", lssscheduling.FilterReasonNotAvailable),
+		)))
+	})
+
+	It("should record the selector errors of rules with a lower priority than the applied rules", func() {
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			*buildServiceTargetConfig(config1, 10, true),
+			*buildServiceTargetConfig(config2, 20, true),
+		}
+
+		deployment := buildLandscaperDeployment(tenant1, nil)
+
+		scheduling := &lssv1alpha1.TargetScheduling{
+			Spec: lssv1alpha1.TargetSchedulingSpec{
+				Rules: []lssv1alpha1.SchedulingRule{
+					{
+						Priority: 4,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config2, Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{Regex: "("}},
+						},
+					},
+					{
+						Priority: 8,
+						ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+							{Name: config1, Namespace: namespace1},
+						},
+						Selector: []lssv1alpha1.Selector{
+							{MatchTenant: &lssv1alpha1.TenantSelector{ID: tenant1}},
+						},
+					},
+				},
+			},
+		}
+
+		config, decision, err := lssscheduling.Schedule(scheduling, deployment, serviceTargetConfigs, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Name).To(Equal(config1))
+		Expect(decision.MatchedRules).To(HaveLen(2))
+		Expect(decision.MatchedRules[0].Index).To(Equal(0))
+		Expect(decision.MatchedRules[0].Applied).To(BeFalse())
+		Expect(decision.MatchedRules[0].Error).To(ContainSubstring("invalid regular expression"))
+		Expect(decision.MatchedRules[1]).To(Equal(lssv1alpha1.MatchedSchedulingRule{Index: 1, Priority: 8, Applied: true}))
+
+		// the selector error fails the scheduling, if the rule could have been applied
+		scheduling.Spec.Rules[0].Priority = 8
+		_, _, err = lssscheduling.Schedule(scheduling, deployment, serviceTargetConfigs, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("invalid regular expression"))
 	})
 })
@@ -6,6 +6,9 @@ package scheduling
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/utils"
@@ -31,6 +34,14 @@ func EvaluateSelector(selector *v1alpha1.Selector, deployment *v1alpha1.Landscap
 		return evaluateTenantSelector(selector.MatchTenant, deployment)
 	} else if selector.MatchLabel != nil {
 		return evaluateLabelSelector(selector.MatchLabel, deployment)
+	} else if selector.MatchPurpose != nil {
+		return evaluateStringSelector(selector.MatchPurpose, deployment.Spec.Purpose)
+	} else if selector.MatchNamespace != nil {
+		return evaluateStringSelector(selector.MatchNamespace, deployment.GetNamespace())
+	} else if selector.MatchHighAvailability != nil {
+		return evaluateHighAvailabilitySelector(selector.MatchHighAvailability, deployment)
+	} else if selector.MatchDataPlane != nil {
+		return evaluateDataPlaneSelector(selector.MatchDataPlane, deployment)
 	} else if len(selector.Or) > 0 {
 		return evaluateOr(selector.Or, deployment)
 	} else if len(selector.And) > 0 {
@@ -43,12 +54,73 @@ func EvaluateSelector(selector *v1alpha1.Selector, deployment *v1alpha1.Landscap
 }
 
 func evaluateTenantSelector(selector *v1alpha1.TenantSelector, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
-	return selector.ID == deployment.Spec.TenantId, nil
+	if len(selector.ID) > 0 {
+		return selector.ID == deployment.Spec.TenantId, nil
+	}
+
+	return evaluateStringSelector(&v1alpha1.StringSelector{
+		Values: selector.IDs,
+		Prefix: selector.Prefix,
+		Regex:  selector.Regex,
+	}, deployment.Spec.TenantId)
 }
 
-// TODO Do we want to support label selectors which check only the existence of the label, ignoring the value?
 func evaluateLabelSelector(labelSelector *v1alpha1.LabelSelector, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
-	return utils.HasLabelWithValue(&deployment.ObjectMeta, labelSelector.Name, labelSelector.Value), nil
+	value, exists := deployment.GetLabels()[labelSelector.Name]
+
+	switch labelSelector.Operator {
+	case "":
+		return utils.HasLabelWithValue(&deployment.ObjectMeta, labelSelector.Name, labelSelector.Value), nil
+	case v1alpha1.LabelSelectorOpIn:
+		return exists && slices.Contains(labelSelector.Values, value), nil
+	case v1alpha1.LabelSelectorOpNotIn:
+		return !exists || !slices.Contains(labelSelector.Values, value), nil
+	case v1alpha1.LabelSelectorOpExists:
+		return exists, nil
+	case v1alpha1.LabelSelectorOpDoesNotExist:
+		return !exists, nil
+	}
+
+	return false, fmt.Errorf("cannot evaluate label selector: unknown operator %q", labelSelector.Operator)
+}
+
+func evaluateStringSelector(selector *v1alpha1.StringSelector, value string) (bool, error) {
+	if len(selector.Values) > 0 {
+		return slices.Contains(selector.Values, value), nil
+	} else if len(selector.Prefix) > 0 {
+		return strings.HasPrefix(value, selector.Prefix), nil
+	} else if len(selector.Regex) > 0 {
+		re, err := regexp.Compile(selector.Regex)
+		if err != nil {
+			return false, fmt.Errorf("cannot evaluate selector: invalid regular expression %q: %w", selector.Regex, err)
+		}
+		return re.MatchString(value), nil
+	}
+
+	return false, fmt.Errorf("cannot evaluate selector: selector must contain values, a prefix or a regular expression")
+}
+
+func evaluateHighAvailabilitySelector(selector *v1alpha1.HighAvailabilitySelector, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
+	haConfig := deployment.Spec.HighAvailabilityConfig
+	if !selector.Enabled {
+		return haConfig == nil, nil
+	}
+	if haConfig == nil {
+		return false, nil
+	}
+
+	return len(selector.ControlPlaneFailureTolerance) == 0 || selector.ControlPlaneFailureTolerance == haConfig.ControlPlaneFailureTolerance, nil
+}
+
+func evaluateDataPlaneSelector(selector *v1alpha1.DataPlaneSelector, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
+	switch selector.Type {
+	case v1alpha1.LandscaperDeploymentDataPlaneTypeInternal:
+		return deployment.IsInternalDataPlane(), nil
+	case v1alpha1.LandscaperDeploymentDataPlaneTypeExternal:
+		return deployment.IsExternalDataPlane(), nil
+	}
+
+	return false, fmt.Errorf("cannot evaluate data plane selector: unknown data plane type %q", selector.Type)
 }
 
 func evaluateOr(selectors []v1alpha1.Selector, deployment *v1alpha1.LandscaperDeployment) (bool, error) {
@@ -98,6 +170,18 @@ func validateSelector(selector *v1alpha1.Selector) error {
 	if selector.MatchLabel != nil {
 		count++
 	}
+	if selector.MatchPurpose != nil {
+		count++
+	}
+	if selector.MatchNamespace != nil {
+		count++
+	}
+	if selector.MatchHighAvailability != nil {
+		count++
+	}
+	if selector.MatchDataPlane != nil {
+		count++
+	}
 	if selector.Or != nil {
 		count++
 	}
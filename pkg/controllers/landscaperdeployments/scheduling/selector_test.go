@@ -136,4 +136,95 @@ var _ = Describe("Evaluation of selectors", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(match).To(BeTrue())
 	})
+
+	It("should evaluate a tenant selector with a list, a prefix and a regular expression", func() {
+		selectors := []*lssv1alpha1.Selector{
+			{MatchTenant: &lssv1alpha1.TenantSelector{IDs: []string{"other-tenant", "test-tenant"}}},
+			{MatchTenant: &lssv1alpha1.TenantSelector{Prefix: "test-"}},
+			{MatchTenant: &lssv1alpha1.TenantSelector{Regex: "^test-[a-z]+$"}},
+		}
+
+		for _, selector := range selectors {
+			match, err := scheduling.EvaluateSelector(selector, newLandscaperDeployment("test-tenant", nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(BeTrue())
+
+			match, err = scheduling.EvaluateSelector(selector, newLandscaperDeployment("another-tenant", nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(BeFalse())
+		}
+
+		_, err := scheduling.EvaluateSelector(&lssv1alpha1.Selector{
+			MatchTenant: &lssv1alpha1.TenantSelector{Regex: "test-("},
+		}, newLandscaperDeployment("test-tenant", nil))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should evaluate label selectors with operators", func() {
+		const labelName = "test-label"
+
+		withValue := newLandscaperDeployment("test-tenant", map[string]string{labelName: "dev"})
+		withOtherValue := newLandscaperDeployment("test-tenant", map[string]string{labelName: "prod"})
+		withoutLabel := newLandscaperDeployment("test-tenant", nil)
+
+		expectMatches := func(selector *lssv1alpha1.LabelSelector, expected ...bool) {
+			for i, deployment := range []*lssv1alpha1.LandscaperDeployment{withValue, withOtherValue, withoutLabel} {
+				match, err := scheduling.EvaluateSelector(&lssv1alpha1.Selector{MatchLabel: selector}, deployment)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(match).To(Equal(expected[i]))
+			}
+		}
+
+		expectMatches(&lssv1alpha1.LabelSelector{Name: labelName, Operator: lssv1alpha1.LabelSelectorOpIn, Values: []string{"dev", "staging"}}, true, false, false)
+		expectMatches(&lssv1alpha1.LabelSelector{Name: labelName, Operator: lssv1alpha1.LabelSelectorOpNotIn, Values: []string{"dev", "staging"}}, false, true, true)
+		expectMatches(&lssv1alpha1.LabelSelector{Name: labelName, Operator: lssv1alpha1.LabelSelectorOpExists}, true, true, false)
+		expectMatches(&lssv1alpha1.LabelSelector{Name: labelName, Operator: lssv1alpha1.LabelSelectorOpDoesNotExist}, false, false, true)
+	})
+
+	It("should evaluate purpose and namespace selectors", func() {
+		deployment := newLandscaperDeployment("test-tenant", nil)
+		deployment.Namespace = "laas-tenant-a"
+		deployment.Spec.Purpose = "productive"
+
+		match, err := scheduling.EvaluateSelector(&lssv1alpha1.Selector{
+			MatchPurpose: &lssv1alpha1.StringSelector{Values: []string{"productive"}},
+		}, deployment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(match).To(BeTrue())
+
+		match, err = scheduling.EvaluateSelector(&lssv1alpha1.Selector{
+			MatchPurpose: &lssv1alpha1.StringSelector{Regex: "^test"},
+		}, deployment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(match).To(BeFalse())
+
+		match, err = scheduling.EvaluateSelector(&lssv1alpha1.Selector{
+			MatchNamespace: &lssv1alpha1.StringSelector{Prefix: "laas-tenant-"},
+		}, deployment)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(match).To(BeTrue())
+	})
+
+	It("should evaluate high availability and data plane selectors", func() {
+		deployment := newLandscaperDeployment("test-tenant", nil)
+		haDeployment := newLandscaperDeployment("test-tenant", nil)
+		haDeployment.Spec.HighAvailabilityConfig = &lssv1alpha1.HighAvailabilityConfig{ControlPlaneFailureTolerance: "zone"}
+		haDeployment.Spec.DataPlane = &lssv1alpha1.DataPlane{}
+
+		expectMatches := func(selector *lssv1alpha1.Selector, expectedDeployment, expectedHaDeployment bool) {
+			match, err := scheduling.EvaluateSelector(selector, deployment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(expectedDeployment))
+
+			match, err = scheduling.EvaluateSelector(selector, haDeployment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(expectedHaDeployment))
+		}
+
+		expectMatches(&lssv1alpha1.Selector{MatchHighAvailability: &lssv1alpha1.HighAvailabilitySelector{Enabled: true}}, false, true)
+		expectMatches(&lssv1alpha1.Selector{MatchHighAvailability: &lssv1alpha1.HighAvailabilitySelector{Enabled: false}}, true, false)
+		expectMatches(&lssv1alpha1.Selector{MatchHighAvailability: &lssv1alpha1.HighAvailabilitySelector{Enabled: true, ControlPlaneFailureTolerance: "node"}}, false, false)
+		expectMatches(&lssv1alpha1.Selector{MatchDataPlane: &lssv1alpha1.DataPlaneSelector{Type: lssv1alpha1.LandscaperDeploymentDataPlaneTypeInternal}}, true, false)
+		expectMatches(&lssv1alpha1.Selector{MatchDataPlane: &lssv1alpha1.DataPlaneSelector{Type: lssv1alpha1.LandscaperDeploymentDataPlaneTypeExternal}}, false, true)
+	})
 })
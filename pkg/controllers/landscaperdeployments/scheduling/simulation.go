@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling
+
+import (
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// Placement is the result of the simulated scheduling of a single LandscaperDeployment.
+type Placement struct {
+	// Deployment references the scheduled LandscaperDeployment.
+	Deployment lssv1alpha1.ObjectReference
+	// TenantID is the tenant of the LandscaperDeployment.
+	TenantID string
+	// ServiceTargetConfig references the selected ServiceTargetConfig. It is nil if the scheduling failed.
+	ServiceTargetConfig *lssv1alpha1.ObjectReference
+	// Decision is the record of the scheduling decision.
+	Decision *lssv1alpha1.SchedulingDecision
+	// Err is the scheduling error, if any.
+	Err error
+}
+
+// SimulationResult is the result of a scheduling simulation.
+type SimulationResult struct {
+	// Placements contains one placement for each LandscaperDeployment, in the order in which they were scheduled.
+	Placements []Placement
+	// ServiceTargetConfigs contains the ServiceTargetConfigs with the instance references they have after the simulation.
+	ServiceTargetConfigs []lssv1alpha1.ServiceTargetConfig
+}
+
+// Simulate schedules the given LandscaperDeployments one after the other without cluster access.
+// Like the LandscaperDeployment controller, only visible ServiceTargetConfigs are considered.
+// For every successfully scheduled deployment, an instance reference is added to the status of the selected
+// ServiceTargetConfig, so that subsequent scheduling decisions take the new load into account.
+// The existing instances are taken into account for the limits per tenant, like the instances which are simulated.
+// The passed objects are not modified.
+func Simulate(
+	scheduling *lssv1alpha1.TargetScheduling,
+	serviceTargetConfigs []lssv1alpha1.ServiceTargetConfig,
+	existingInstances []lssv1alpha1.Instance,
+	deployments []lssv1alpha1.LandscaperDeployment) *SimulationResult {
+
+	configs := make([]lssv1alpha1.ServiceTargetConfig, 0, len(serviceTargetConfigs))
+	for i := range serviceTargetConfigs {
+		config := &serviceTargetConfigs[i]
+		if utils.HasLabelWithValue(config, lssv1alpha1.ServiceTargetConfigVisibleLabelName, "true") {
+			configs = append(configs, *config.DeepCopy())
+		}
+	}
+
+	result := &SimulationResult{
+		Placements: make([]Placement, 0, len(deployments)),
+	}
+	instances := make([]lssv1alpha1.Instance, 0, len(existingInstances)+len(deployments))
+	for i := range existingInstances {
+		instances = append(instances, *existingInstances[i].DeepCopy())
+	}
+
+	for i := range deployments {
+		deployment := &deployments[i]
+		placement := Placement{
+			Deployment: lssv1alpha1.ObjectReference{
+				Name:      deployment.Name,
+				Namespace: deployment.Namespace,
+			},
+			TenantID: deployment.Spec.TenantId,
+		}
+
+		winner, decision, err := Schedule(scheduling, deployment, configs, instances)
+		placement.Decision = decision
+		placement.Err = err
+
+		if err == nil {
+			placement.ServiceTargetConfig = &lssv1alpha1.ObjectReference{
+				Name:      winner.Name,
+				Namespace: winner.Namespace,
+			}
+
+			// the simulated instance has the same name and namespace as its deployment
+			instance := lssv1alpha1.Instance{}
+			instance.Name = deployment.Name
+			instance.Namespace = deployment.Namespace
+			instance.Labels = deployment.Labels
+			instance.Spec.TenantId = deployment.Spec.TenantId
+			instance.Spec.ServiceTargetConfigRef = *placement.ServiceTargetConfig
+			instances = append(instances, instance)
+
+			winner.Status.InstanceRefs = append(winner.Status.InstanceRefs, placement.Deployment)
+		}
+
+		result.Placements = append(result.Placements, placement)
+	}
+
+	result.ServiceTargetConfigs = configs
+	return result
+}
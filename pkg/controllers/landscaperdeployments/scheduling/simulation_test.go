@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduling_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+)
+
+var _ = Describe("Simulation", func() {
+
+	const (
+		namespace1 = "test-namespace-1"
+
+		config1 = "test-config-1"
+		config2 = "test-config-2"
+		config3 = "test-config-3"
+
+		tenant1 = "test-tenant-1"
+	)
+
+	buildLandscaperDeployment := func(name, tenantID string) lssv1alpha1.LandscaperDeployment {
+		return lssv1alpha1.LandscaperDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace1,
+			},
+			Spec: lssv1alpha1.LandscaperDeploymentSpec{
+				TenantId: tenantID,
+			},
+		}
+	}
+
+	buildServiceTargetConfig := func(name string, prio int64, visible string) lssv1alpha1.ServiceTargetConfig {
+		return lssv1alpha1.ServiceTargetConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace1,
+				Labels:    map[string]string{lssv1alpha1.ServiceTargetConfigVisibleLabelName: visible},
+			},
+			Spec: lssv1alpha1.ServiceTargetConfigSpec{
+				Priority: prio,
+			},
+		}
+	}
+
+	It("should schedule the deployments sequentially and update the load", func() {
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			buildServiceTargetConfig(config1, 30, "true"),
+			buildServiceTargetConfig(config2, 10, "true"),
+			buildServiceTargetConfig(config3, 100, "false"), // invisible
+		}
+		serviceTargetConfigs[0].Spec.MaxInstances = ptr.To[int64](2)
+
+		deployments := []lssv1alpha1.LandscaperDeployment{
+			buildLandscaperDeployment("deployment-1", tenant1),
+			buildLandscaperDeployment("deployment-2", tenant1),
+			buildLandscaperDeployment("deployment-3", tenant1),
+		}
+
+		result := lssscheduling.Simulate(nil, serviceTargetConfigs, nil, deployments)
+
+		// config1 (prio 30) wins twice, because 30/2 > 10/1; afterwards it is full.
+		Expect(result.Placements).To(HaveLen(3))
+		for i, expected := range []string{config1, config1, config2} {
+			Expect(result.Placements[i].Err).NotTo(HaveOccurred())
+			Expect(result.Placements[i].ServiceTargetConfig).NotTo(BeNil())
+			Expect(result.Placements[i].ServiceTargetConfig.Name).To(Equal(expected))
+		}
+
+		Expect(result.ServiceTargetConfigs).To(HaveLen(2))
+		Expect(result.ServiceTargetConfigs[0].Status.InstanceRefs).To(HaveLen(2))
+		Expect(result.ServiceTargetConfigs[1].Status.InstanceRefs).To(HaveLen(1))
+
+		// the input is not modified
+		Expect(serviceTargetConfigs[0].Status.InstanceRefs).To(BeEmpty())
+	})
+
+	It("should report deployments which cannot be scheduled", func() {
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			buildServiceTargetConfig(config1, 10, "true"),
+		}
+		serviceTargetConfigs[0].Spec.MaxInstancesPerTenant = ptr.To[int64](1)
+
+		deployments := []lssv1alpha1.LandscaperDeployment{
+			buildLandscaperDeployment("deployment-1", tenant1),
+			buildLandscaperDeployment("deployment-2", tenant1),
+		}
+
+		result := lssscheduling.Simulate(nil, serviceTargetConfigs, nil, deployments)
+
+		Expect(result.Placements).To(HaveLen(2))
+		Expect(result.Placements[0].Err).NotTo(HaveOccurred())
+		Expect(result.Placements[1].Err).To(HaveOccurred())
+		Expect(errors.Is(result.Placements[1].Err, lssscheduling.ErrNoCapacity)).To(BeTrue())
+		Expect(result.Placements[1].ServiceTargetConfig).To(BeNil())
+		Expect(result.Placements[1].Decision).NotTo(BeNil())
+	})
+
+	It("should count the existing instances of the tenant", func() {
+		serviceTargetConfigs := []lssv1alpha1.ServiceTargetConfig{
+			buildServiceTargetConfig(config1, 10, "true"),
+		}
+		serviceTargetConfigs[0].Spec.MaxInstancesPerTenant = ptr.To[int64](1)
+
+		existing := lssv1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "existing",
+				Namespace: namespace1,
+			},
+			Spec: lssv1alpha1.InstanceSpec{
+				TenantId:               tenant1,
+				ServiceTargetConfigRef: lssv1alpha1.ObjectReference{Name: config1, Namespace: namespace1},
+			},
+		}
+
+		deployments := []lssv1alpha1.LandscaperDeployment{
+			buildLandscaperDeployment("deployment-1", tenant1),
+		}
+
+		result := lssscheduling.Simulate(nil, serviceTargetConfigs, []lssv1alpha1.Instance{existing}, deployments)
+
+		Expect(result.Placements).To(HaveLen(1))
+		Expect(errors.Is(result.Placements[0].Err, lssscheduling.ErrNoCapacity)).To(BeTrue())
+	})
+})
@@ -21,7 +21,7 @@ import (
 // AddControllerToManager adds the Namespaceregistration Controller to the manager
 func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.TargetShootSidecarConfiguration) error {
 	log := logger.Reconciles("NamespaceRegistrationController", "NamespaceRegistration")
-	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), config)
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("landscaper-service-namespaceregistration"), config)
 	if err != nil {
 		return err
 	}
@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -48,13 +49,14 @@ type Controller struct {
 	HandleDeleteFunc func(ctx context.Context, namespaceRegistration *lssv1alpha1.NamespaceRegistration) (reconcile.Result, error)
 }
 
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.TargetShootSidecarConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder, config *config.TargetShootSidecarConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log: logger,
 	}
 	ctrl.ReconcileFunc = ctrl.reconcile
 	ctrl.HandleDeleteFunc = ctrl.handleDelete
 	op := operation.NewTargetShootSidecarOperation(c, scheme, config)
+	op.SetEventRecorder(eventRecorder)
 	ctrl.TargetShootSidecarOperation = *op
 	return ctrl, nil
 }
@@ -84,16 +86,16 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{RequeueAfter: requeueAfterDuration}, nil
 	}
 
-	if !strings.HasPrefix(namespaceRegistration.Name, subjectsync.CUSTOM_NS_PREFIX) {
+	if !strings.HasPrefix(namespaceRegistration.Name, lssv1alpha1.CustomNamespacePrefix) {
 		if namespaceRegistration.Status.Phase != PhaseFailed ||
 			namespaceRegistration.Status.LastError == nil ||
 			(namespaceRegistration.Status.LastError != nil && namespaceRegistration.Status.LastError.Reason != ReasonInvalidName) {
 
-			err := fmt.Errorf("name must start with %q", subjectsync.CUSTOM_NS_PREFIX)
+			err := fmt.Errorf("name must start with %q", lssv1alpha1.CustomNamespacePrefix)
 			lastError := c.createError(namespaceRegistration.Status.Phase, ReasonInvalidName, err)
 			c.updateStatus(namespaceRegistration, PhaseFailed, lastError)
 			if err := c.Client().Status().Update(ctx, namespaceRegistration); err != nil {
-				logger.Error(err, "failed updating namespaceregistration with invalid name - must start with "+subjectsync.CUSTOM_NS_PREFIX)
+				logger.Error(err, "failed updating namespaceregistration with invalid name - must start with "+lssv1alpha1.CustomNamespacePrefix)
 				return reconcile.Result{RequeueAfter: requeueAfterDuration}, nil
 			}
 		}
@@ -151,6 +153,8 @@ func (c *Controller) removeResourcesAndNamespace(ctx context.Context, namespaceR
 			logger.Error(err, "failed updating status of namespaceregistration when starting deletion")
 			return reconcile.Result{RequeueAfter: requeueAfterDuration}, nil
 		}
+		c.EventRecorder().Eventf(namespaceRegistration, corev1.EventTypeNormal, "DeletionStarted",
+			"deleting the resources of namespace %s", namespace.Name)
 	}
 
 	// check if installations, executions, deploy items or target sync objects are still there
@@ -164,6 +168,8 @@ func (c *Controller) removeResourcesAndNamespace(ctx context.Context, namespaceR
 		if err != nil {
 			return c.logErrorUpdateAndRetry(ctx, namespaceRegistration, PhaseDeleting, "failed deleting installations", err)
 		}
+		c.EventRecorder().Eventf(namespaceRegistration, corev1.EventTypeNormal, "DeletingInstallations",
+			"triggered the deletion of the installations in namespace %s", namespace.Name)
 
 		return c.logErrorUpdateAndRetry(ctx, namespaceRegistration, PhaseDeleting, "namespace contains installations", nil)
 	}
@@ -230,6 +236,7 @@ func (c *Controller) removeAccessDataAndNamespace(ctx context.Context, namespace
 	if err := c.Client().Delete(ctx, namespace); err != nil {
 		return c.logErrorUpdateAndRetry(ctx, namespaceRegistration, PhaseDeleting, "failed deleting namespace", err)
 	}
+	c.EventRecorder().Eventf(namespaceRegistration, corev1.EventTypeNormal, "NamespaceDeleted", "deleted namespace %s", namespace.Name)
 
 	controllerutil.RemoveFinalizer(namespaceRegistration, lssv1alpha1.LandscaperServiceFinalizer)
 	if err := c.Client().Update(ctx, namespaceRegistration); err != nil {
@@ -265,6 +272,8 @@ func (c *Controller) reconcile(ctx context.Context, namespaceRegistration *lssv1
 		if !apierrors.IsAlreadyExists(err) {
 			return c.logErrorUpdateAndRetry(ctx, namespaceRegistration, PhaseCreating, "failed creating namespace", err)
 		}
+	} else {
+		c.EventRecorder().Eventf(namespaceRegistration, corev1.EventTypeNormal, "NamespaceCreated", "created namespace %s", namespace.Name)
 	}
 
 	// load subjectList
@@ -302,6 +311,8 @@ func (c *Controller) reconcile(ctx context.Context, namespaceRegistration *lssv1
 		logger.Error(err, "failed updating status of namespaceregistration after completion")
 		return reconcile.Result{RequeueAfter: requeueAfterDuration}, nil
 	}
+	c.EventRecorder().Eventf(namespaceRegistration, corev1.EventTypeNormal, "Completed",
+		"namespace %s and its roles have been created", namespace.Name)
 	return reconcile.Result{}, nil
 }
 
@@ -331,8 +342,17 @@ func (c *Controller) logErrorUpdateAndRetry(ctx context.Context, namespaceRegist
 
 	if err != nil {
 		logger.Error(err, msg)
+		reason := "CreationFailed"
+		if phase == PhaseDeleting {
+			reason = "DeletionFailed"
+		}
+		c.EventRecorder().Eventf(namespaceRegistration, corev1.EventTypeWarning, reason, "%s: %s", msg, err.Error())
 	} else {
 		logger.Info(msg)
+		// the namespace registration is requeued until the condition is fulfilled, so that the event is only recorded once
+		if isWaitingStatusChanged(namespaceRegistration, phase, msg) {
+			c.EventRecorder().Event(namespaceRegistration, corev1.EventTypeNormal, "Waiting", msg)
+		}
 	}
 
 	lastError := c.createError(namespaceRegistration.Status.Phase, msg, err)
@@ -344,6 +364,15 @@ func (c *Controller) logErrorUpdateAndRetry(ctx context.Context, namespaceRegist
 	return reconcile.Result{RequeueAfter: requeueAfterDuration}, nil
 }
 
+// isWaitingStatusChanged returns whether the phase or the message of a namespace registration, which is waiting without error, changes.
+func isWaitingStatusChanged(namespaceRegistration *lssv1alpha1.NamespaceRegistration, phase, msg string) bool {
+	lastError := namespaceRegistration.Status.LastError
+	if namespaceRegistration.Status.Phase != phase || lastError == nil {
+		return true
+	}
+	return lastError.Reason != msg || len(lastError.Message) > 0
+}
+
 func (c *Controller) updateStatus(namespaceRegistration *lssv1alpha1.NamespaceRegistration, phase string,
 	lastError *lssv1alpha1.Error) {
 	namespaceRegistration.Status.Phase = phase
@@ -18,6 +18,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -57,7 +58,7 @@ var _ = Describe("Reconcile", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// reconcile
-		namespaceRegistration := state.GetNamespaceRegistration(subjectsync.CUSTOM_NS_PREFIX + "test-namespace-1")
+		namespaceRegistration := state.GetNamespaceRegistration(lssv1alpha1.CustomNamespacePrefix + "test-namespace-1")
 		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
 
 		// check finalizer and phase
@@ -106,7 +107,10 @@ var _ = Describe("Reconcile", func() {
 		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
 		Expect(err).ToNot(HaveOccurred())
 
-		namespaceRegistration := state.GetNamespaceRegistration(subjectsync.CUSTOM_NS_PREFIX + "test-namespace-3")
+		namespaceRegistration := state.GetNamespaceRegistration(lssv1alpha1.CustomNamespacePrefix + "test-namespace-3")
+
+		recorder := record.NewFakeRecorder(100)
+		ctrl.(*namespaceregistration.Controller).SetEventRecorder(recorder)
 
 		// reconcile
 		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
@@ -147,6 +151,11 @@ var _ = Describe("Reconcile", func() {
 		Expect(namespaceRegistration.Status.Phase).To(Equal("Deleting"))
 		Expect(testenv.Client.Get(ctx, types.NamespacedName{Name: namespaceRegistration.Name}, &namespace)).To(Succeed())
 		Expect(namespace.DeletionTimestamp.IsZero()).To(BeTrue())
+		Eventually(recorder.Events).Should(Receive(Equal("Normal Waiting namespace contains installations")))
+
+		// the waiting event is only recorded once while the namespace registration is pending
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
+		Consistently(recorder.Events).ShouldNot(Receive(HavePrefix("Normal Waiting")))
 
 		// successful deletion
 		Expect(testenv.Client.Delete(ctx, installation)).To(Succeed())
@@ -166,7 +175,7 @@ var _ = Describe("Reconcile", func() {
 		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test4")
 		Expect(err).ToNot(HaveOccurred())
 
-		namespaceRegistration := state.GetNamespaceRegistration(subjectsync.CUSTOM_NS_PREFIX + "test-namespace-4")
+		namespaceRegistration := state.GetNamespaceRegistration(lssv1alpha1.CustomNamespacePrefix + "test-namespace-4")
 		//reconcile
 		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(namespaceRegistration), namespaceRegistration)).To(Succeed())
@@ -209,7 +218,7 @@ var _ = Describe("Reconcile", func() {
 		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test5")
 		Expect(err).ToNot(HaveOccurred())
 
-		namespaceRegistration := state.GetNamespaceRegistration(subjectsync.CUSTOM_NS_PREFIX + "test-namespace-5")
+		namespaceRegistration := state.GetNamespaceRegistration(lssv1alpha1.CustomNamespacePrefix + "test-namespace-5")
 		//reconcile
 		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(namespaceRegistration), namespaceRegistration)).To(Succeed())
@@ -252,7 +261,7 @@ var _ = Describe("Reconcile", func() {
 		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test6")
 		Expect(err).ToNot(HaveOccurred())
 
-		namespaceRegistration := state.GetNamespaceRegistration(subjectsync.CUSTOM_NS_PREFIX + "test-namespace-6")
+		namespaceRegistration := state.GetNamespaceRegistration(lssv1alpha1.CustomNamespacePrefix + "test-namespace-6")
 		//reconcile
 		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(namespaceRegistration), namespaceRegistration)).To(Succeed())
@@ -302,7 +311,7 @@ var _ = Describe("Reconcile", func() {
 		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test7")
 		Expect(err).ToNot(HaveOccurred())
 
-		namespaceRegistration := state.GetNamespaceRegistration(subjectsync.CUSTOM_NS_PREFIX + "test-namespace-7")
+		namespaceRegistration := state.GetNamespaceRegistration(lssv1alpha1.CustomNamespacePrefix + "test-namespace-7")
 		//reconcile
 		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(namespaceRegistration), namespaceRegistration)).To(Succeed())
@@ -355,7 +364,7 @@ var _ = Describe("Reconcile", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// reconcile namespace registration
-		namespaceRegistration := state.GetNamespaceRegistration(subjectsync.CUSTOM_NS_PREFIX + "test-namespace-8")
+		namespaceRegistration := state.GetNamespaceRegistration(lssv1alpha1.CustomNamespacePrefix + "test-namespace-8")
 		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(namespaceRegistration), namespaceRegistration)).To(Succeed())
 		Expect(namespaceRegistration.Status.Phase).To(Equal("Completed"))
@@ -415,7 +424,7 @@ var _ = Describe("Reconcile", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// reconcile namespace registration
-		namespaceRegistration := state.GetNamespaceRegistration(subjectsync.CUSTOM_NS_PREFIX + "test-namespace-9")
+		namespaceRegistration := state.GetNamespaceRegistration(lssv1alpha1.CustomNamespacePrefix + "test-namespace-9")
 		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(namespaceRegistration))
 		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(namespaceRegistration), namespaceRegistration)).To(Succeed())
 		Expect(namespaceRegistration.Status.Phase).To(Equal("Completed"))
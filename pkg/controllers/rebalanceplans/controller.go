@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalanceplans
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// Controller is the rebalanceplan controller
+type Controller struct {
+	operation.Operation
+	log logging.Logger
+	// apiReader reads the service target configs and instances directly from the api server before a migration is started,
+	// so that the migrations started by the previous reconciles are always taken into account.
+	apiReader client.Reader
+}
+
+// NewTestActuator creates a new controller for testing purposes.
+func NewTestActuator(op operation.Operation, logger logging.Logger) *Controller {
+	return &Controller{
+		Operation: op,
+		log:       logger,
+		apiReader: op.Client(),
+	}
+}
+
+// NewController returns a new rebalanceplan controller
+func NewController(logger logging.Logger, c client.Client, apiReader client.Reader, scheme *runtime.Scheme, eventRecorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+	ctrl := &Controller{
+		log:       logger,
+		apiReader: apiReader,
+	}
+	op := operation.NewOperation(c, scheme, config)
+	op.SetEventRecorder(eventRecorder)
+	ctrl.Operation = *op
+	return ctrl, nil
+}
+
+// Reconcile reconciles requests for rebalanceplans
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	plan := &lssv1alpha1.RebalancePlan{}
+	if err := c.Client().Get(ctx, req.NamespacedName, plan); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !plan.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	c.Operation.Scheme().Default(plan)
+	errHdl := c.handleErrorFunc(plan)
+
+	result, err := c.reconcile(ctx, plan)
+	return result, errHdl(ctx, err)
+}
+
+// handleErrorFunc updates the error status of a rebalance plan
+func (c *Controller) handleErrorFunc(plan *lssv1alpha1.RebalancePlan) func(ctx context.Context, err error) error {
+	old := plan.DeepCopy()
+	return func(ctx context.Context, err error) error {
+		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(plan).String()})
+		plan.Status.LastError = lsserrors.TryUpdateError(plan.Status.LastError, err)
+		utils.RecordErrorEvent(c.EventRecorder(), plan, "ReconcileFailed", err)
+
+		if !reflect.DeepEqual(old.Status, plan.Status) {
+			if err2 := c.Client().Status().Update(ctx, plan); err2 != nil {
+				if apierrors.IsConflict(err2) {
+					// reduce logging
+					logger.Info(fmt.Sprintf("unable to update status: %s", err2.Error()))
+				} else {
+					logger.Error(err2, "unable to update status")
+				}
+
+				// retry on conflict
+				if err != nil {
+					return err2
+				}
+			}
+		}
+		return err
+	}
+}
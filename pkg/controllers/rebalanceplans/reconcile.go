@@ -0,0 +1,321 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalanceplans
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+const (
+	// ExecutionRequeueDuration is the duration after which an executing rebalance plan is reconciled again.
+	ExecutionRequeueDuration = time.Second * 30
+
+	approvedBeforePlannedMessage = "the plan has been approved before it has been computed, revoke and grant the approval again to execute it"
+)
+
+// reconcile computes the proposed migrations of a rebalance plan, or executes them once the plan has been approved.
+// The plan is computed when the rebalance plan is created, and again when its spec is changed before it is approved.
+// The approval only takes effect when it has been given after the plan has been computed, so that the proposed
+// migrations can be reviewed before they are executed.
+// The status is persisted by the error handler.
+func (c *Controller) reconcile(ctx context.Context, plan *lssv1alpha1.RebalancePlan) (reconcile.Result, error) {
+	currOp := "Reconcile"
+
+	switch {
+	case plan.Status.Phase == lssv1alpha1.RebalancePlanPhaseCompleted:
+		return reconcile.Result{}, nil
+
+	case len(plan.Status.Phase) == 0,
+		plan.Status.Phase == lssv1alpha1.RebalancePlanPhasePlanned && !plan.Spec.Approved && plan.Status.ObservedGeneration != plan.Generation:
+		if err := c.plan(ctx, plan); err != nil {
+			return reconcile.Result{}, lsserrors.NewWrappedError(err, currOp, "PlanRebalance", err.Error())
+		}
+		if plan.Spec.Approved {
+			plan.Status.Message = approvedBeforePlannedMessage
+		}
+		return reconcile.Result{}, nil
+
+	case plan.Status.Phase == lssv1alpha1.RebalancePlanPhasePlanned && plan.Status.ObservedGeneration == plan.Generation:
+		// the approval has been given before the plan has been computed and has not been reviewed
+		if plan.Spec.Approved {
+			plan.Status.Message = approvedBeforePlannedMessage
+		}
+		return reconcile.Result{}, nil
+
+	case !plan.Spec.Approved:
+		if plan.Status.Phase == lssv1alpha1.RebalancePlanPhaseExecuting {
+			plan.Status.Message = "execution is paused, because the plan is not approved"
+		}
+		return reconcile.Result{}, nil
+
+	default:
+		result, err := c.execute(ctx, plan)
+		if err != nil {
+			return reconcile.Result{}, lsserrors.NewWrappedError(err, currOp, "ExecuteRebalancePlan", err.Error())
+		}
+		return result, nil
+	}
+}
+
+// plan evaluates the existing instances against the current target schedulings and service target configs,
+// and records the proposed migrations in the status of the rebalance plan. No instance is modified.
+func (c *Controller) plan(ctx context.Context, plan *lssv1alpha1.RebalancePlan) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(plan).String()},
+		lc.KeyMethod, "plan")
+
+	// the cordoned service target configs are included, so that their instances are left to the drain
+	serviceTargetConfigs, err := lssscheduling.ListVisibleServiceTargetConfigs(ctx, c.Client())
+	if err != nil {
+		return err
+	}
+
+	scheduling, err := lssscheduling.GetMergedScheduling(ctx, c.Client(), c.Config().Scheduling, c.Config().SchedulingSelector)
+	if err != nil {
+		return err
+	}
+
+	deploymentList := &lssv1alpha1.LandscaperDeploymentList{}
+	if err := c.Client().List(ctx, deploymentList); err != nil {
+		return fmt.Errorf("unable to list landscaper deployments: %w", err)
+	}
+
+	instanceList := &lssv1alpha1.InstanceList{}
+	if err := c.Client().List(ctx, instanceList); err != nil {
+		return fmt.Errorf("unable to list instances: %w", err)
+	}
+
+	moves := lssscheduling.PlanRebalance(scheduling.Scheduling, serviceTargetConfigs, deploymentList.Items, instanceList.Items,
+		plan.Spec.ServiceTargetConfigs, plan.Spec.MaxMoves)
+	if len(moves) == 0 {
+		// an empty list is omitted in the stored status
+		moves = nil
+	}
+
+	now := metav1.Now()
+	plan.Status.ObservedGeneration = plan.Generation
+	plan.Status.Phase = lssv1alpha1.RebalancePlanPhasePlanned
+	plan.Status.PlanTime = &now
+	plan.Status.Moves = moves
+	plan.Status.Message = fmt.Sprintf("%d migration(s) proposed", len(moves))
+
+	logger.Info("Computed rebalance plan", "moves", len(moves))
+	c.EventRecorder().Event(plan, corev1.EventTypeNormal, "Planned", plan.Status.Message)
+	return nil
+}
+
+// execute executes the approved migrations of a rebalance plan one after the other.
+// The next migration is started when the previous one has finished. A migration is skipped if the plan is outdated for its instance.
+func (c *Controller) execute(ctx context.Context, plan *lssv1alpha1.RebalancePlan) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(plan).String()},
+		lc.KeyMethod, "execute")
+
+	if plan.Status.Phase == lssv1alpha1.RebalancePlanPhasePlanned {
+		logger.Info("Starting execution of rebalance plan")
+		plan.Status.Phase = lssv1alpha1.RebalancePlanPhaseExecuting
+		c.EventRecorder().Eventf(plan, corev1.EventTypeNormal, "ExecutionStarted", "executing %d migration(s)", len(plan.Status.Moves))
+	}
+	plan.Status.ObservedGeneration = plan.Generation
+
+	for i := range plan.Status.Moves {
+		move := &plan.Status.Moves[i]
+
+		switch move.Phase {
+		case lssv1alpha1.RebalanceMovePhaseMigrating:
+			migrating, err := c.checkMigration(ctx, move)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+			if migrating {
+				plan.Status.Message = fmt.Sprintf("migrating instance %s to service target config %s",
+					move.Instance.NamespacedName().String(), move.Destination.NamespacedName().String())
+				return reconcile.Result{RequeueAfter: ExecutionRequeueDuration}, nil
+			}
+
+		case lssv1alpha1.RebalanceMovePhaseProposed:
+			if err := c.startMigration(ctx, plan, move); err != nil {
+				return reconcile.Result{}, err
+			}
+			if move.Phase == lssv1alpha1.RebalanceMovePhaseMigrating {
+				plan.Status.Message = fmt.Sprintf("migrating instance %s to service target config %s",
+					move.Instance.NamespacedName().String(), move.Destination.NamespacedName().String())
+				return reconcile.Result{RequeueAfter: ExecutionRequeueDuration}, nil
+			}
+		}
+	}
+
+	now := metav1.Now()
+	succeeded := 0
+	for i := range plan.Status.Moves {
+		if plan.Status.Moves[i].Phase == lssv1alpha1.RebalanceMovePhaseSucceeded {
+			succeeded++
+		}
+	}
+	plan.Status.Phase = lssv1alpha1.RebalancePlanPhaseCompleted
+	plan.Status.CompletionTime = &now
+	plan.Status.Message = fmt.Sprintf("%d of %d migration(s) succeeded", succeeded, len(plan.Status.Moves))
+
+	logger.Info("Execution of rebalance plan has finished")
+	c.EventRecorder().Event(plan, corev1.EventTypeNormal, "Completed", plan.Status.Message)
+	return reconcile.Result{}, nil
+}
+
+// checkMigration checks whether the instance of a started migration is still being migrated.
+// If the migration has finished, the phase of the move is updated.
+func (c *Controller) checkMigration(ctx context.Context, move *lssv1alpha1.RebalanceMove) (bool, error) {
+	instance, err := c.getInstance(ctx, &move.Instance)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case instance == nil:
+		skip(move, "instance does not exist anymore")
+	case instance.IsMigrating():
+		return true, nil
+	case instance.Spec.ServiceTargetConfigRef.Equals(&move.Destination):
+		move.Phase = lssv1alpha1.RebalanceMovePhaseSucceeded
+	default:
+		skip(move, fmt.Sprintf("instance has been moved to service target config %s by another operation",
+			instance.Spec.ServiceTargetConfigRef.NamespacedName().String()))
+	}
+	return false, nil
+}
+
+// startMigration starts the migration of the instance of a proposed move to its destination, like the drain of a service target config.
+// If the instance or the destination has changed since the plan has been computed, the move is skipped.
+func (c *Controller) startMigration(ctx context.Context, plan *lssv1alpha1.RebalancePlan, move *lssv1alpha1.RebalanceMove) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(plan).String()},
+		lc.KeyMethod, "startMigration")
+
+	instance, err := c.getInstance(ctx, &move.Instance)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case instance == nil:
+		skip(move, "instance does not exist anymore")
+		return nil
+	case instance.IsMigrating() && instance.Spec.ServiceTargetConfigRef.Equals(&move.Destination):
+		// the migration has been started, but the status of the plan could not be updated
+		move.Phase = lssv1alpha1.RebalanceMovePhaseMigrating
+		return nil
+	case instance.IsMigrating():
+		skip(move, "instance is being migrated by another operation")
+		return nil
+	case !instance.Spec.ServiceTargetConfigRef.Equals(&move.Source):
+		skip(move, fmt.Sprintf("instance has been moved to service target config %s since the plan has been computed",
+			instance.Spec.ServiceTargetConfigRef.NamespacedName().String()))
+		return nil
+	case utils.HasOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationIgnore):
+		skip(move, "instance has ignore annotation")
+		return nil
+	case instance.Status.ServiceTargetConfigRef == nil:
+		skip(move, "instance has not been reconciled yet")
+		return nil
+	}
+
+	destination := &lssv1alpha1.ServiceTargetConfig{}
+	if err := c.apiReader.Get(ctx, move.Destination.NamespacedName(), destination); err != nil {
+		if apierrors.IsNotFound(err) {
+			skip(move, "destination service target config does not exist anymore")
+			return nil
+		}
+		return fmt.Errorf("unable to get service target config %s: %w", move.Destination.NamespacedName().String(), err)
+	}
+
+	if destination.Spec.Cordoned {
+		skip(move, "destination service target config is cordoned")
+		return nil
+	}
+
+	reason, err := c.checkDestination(ctx, instance, move)
+	if err != nil {
+		return err
+	}
+	if len(reason) > 0 {
+		skip(move, reason)
+		return nil
+	}
+
+	logger.Info("Starting migration of instance", lc.KeyResource, move.Instance.NamespacedName().String(),
+		"destination", move.Destination.NamespacedName().String())
+
+	instance.Spec.ServiceTargetConfigRef = move.Destination
+	utils.SetOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationMigrate)
+	if err := c.Client().Update(ctx, instance); err != nil {
+		return fmt.Errorf("unable to start migration of instance %s: %w", move.Instance.NamespacedName().String(), err)
+	}
+	c.EventRecorder().Eventf(plan, corev1.EventTypeNormal, "MigrationStarted",
+		"migrating instance %s to service target config %s", move.Instance.NamespacedName().String(), move.Destination.NamespacedName().String())
+
+	move.Phase = lssv1alpha1.RebalanceMovePhaseMigrating
+	return nil
+}
+
+// checkDestination evaluates the current target scheduling rules for the deployment of the instance of a move,
+// and returns why its destination is not eligible anymore, or an empty string if it is.
+// The service target configs and instances are read from the api server, so that the capacity of the destination
+// includes the migrations which have just been started.
+func (c *Controller) checkDestination(ctx context.Context, instance *lssv1alpha1.Instance, move *lssv1alpha1.RebalanceMove) (string, error) {
+	deployment := &lssv1alpha1.LandscaperDeployment{}
+	if err := c.apiReader.Get(ctx, move.Deployment.NamespacedName(), deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "landscaper deployment does not exist anymore", nil
+		}
+		return "", fmt.Errorf("unable to get landscaper deployment %s: %w", move.Deployment.NamespacedName().String(), err)
+	}
+
+	serviceTargetConfigs, err := lssscheduling.GetVisibleServiceTargetConfigs(ctx, c.apiReader)
+	if err != nil {
+		return "", err
+	}
+
+	scheduling, err := lssscheduling.GetMergedScheduling(ctx, c.apiReader, c.Config().Scheduling, c.Config().SchedulingSelector)
+	if err != nil {
+		return "", err
+	}
+
+	instanceList := &lssv1alpha1.InstanceList{}
+	if err := c.apiReader.List(ctx, instanceList); err != nil {
+		return "", fmt.Errorf("unable to list instances: %w", err)
+	}
+
+	return lssscheduling.CheckRebalanceDestination(scheduling.Scheduling, serviceTargetConfigs, deployment, instanceList.Items,
+		instance, &move.Destination), nil
+}
+
+// getInstance returns the referenced instance, or nil if it does not exist.
+func (c *Controller) getInstance(ctx context.Context, ref *lssv1alpha1.ObjectReference) (*lssv1alpha1.Instance, error) {
+	instance := &lssv1alpha1.Instance{}
+	if err := c.Client().Get(ctx, ref.NamespacedName(), instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get instance %s: %w", ref.NamespacedName().String(), err)
+	}
+	return instance, nil
+}
+
+// skip marks a move as skipped.
+func skip(move *lssv1alpha1.RebalanceMove, message string) {
+	move.Phase = lssv1alpha1.RebalanceMovePhaseSkipped
+	move.Message = message
+}
@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalanceplans_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/utils/ptr"
+
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	rebalanceplanscontroller "github.com/gardener/landscaper-service/pkg/controllers/rebalanceplans"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	testutils "github.com/gardener/landscaper-service/test/utils"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+var _ = Describe("Reconcile", func() {
+	var (
+		op    *operation.Operation
+		ctrl  *rebalanceplanscontroller.Controller
+		ctx   context.Context
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		op = operation.NewOperation(testenv.Client, envtest.LandscaperServiceScheme, testutils.DefaultControllerConfiguration())
+		ctrl = rebalanceplanscontroller.NewTestActuator(*op, logging.Discard())
+	})
+
+	AfterEach(func() {
+		defer ctx.Done()
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).ToNot(HaveOccurred())
+		}
+	})
+
+	approve := func(plan *lssv1alpha1.RebalancePlan) {
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		plan.Spec.Approved = true
+		Expect(testenv.Client.Update(ctx, plan)).To(Succeed())
+	}
+
+	It("should propose moves without modifying the instances", func() {
+		plan := state.GetRebalancePlan("plan")
+		instance := state.GetInstance("first")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhasePlanned))
+		Expect(plan.Status.ObservedGeneration).To(Equal(plan.Generation))
+		Expect(plan.Status.PlanTime).ToNot(BeNil())
+		Expect(plan.Status.Moves).To(HaveLen(1))
+
+		move := plan.Status.Moves[0]
+		Expect(move.Instance.Name).To(Equal("first"))
+		Expect(move.Deployment.Name).To(Equal("first"))
+		Expect(move.Source.Name).To(Equal("crowded"))
+		Expect(move.Destination.Name).To(Equal("empty"))
+		Expect(move.Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseProposed))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Spec.ServiceTargetConfigRef.Name).To(Equal("crowded"))
+		Expect(instance.Annotations).ToNot(HaveKey(lssv1alpha1.LandscaperServiceOperationAnnotation))
+
+		// the plan is not computed again as long as the spec is not changed
+		planTime := plan.Status.PlanTime
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.PlanTime.Equal(planTime)).To(BeTrue())
+	})
+
+	It("should leave the instances on cordoned service target configs to the drain", func() {
+		plan := state.GetRebalancePlan("plan")
+
+		source := state.GetConfig("crowded")
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(source), source)).To(Succeed())
+		source.Spec.Cordoned = true
+		Expect(testenv.Client.Update(ctx, source)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhasePlanned))
+		Expect(plan.Status.Moves).To(BeEmpty())
+	})
+
+	It("should compute the plan again if the spec is changed before the approval", func() {
+		plan := state.GetRebalancePlan("plan")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Moves).To(HaveLen(1))
+
+		plan.Spec.MaxMoves = ptr.To(0)
+		Expect(testenv.Client.Update(ctx, plan)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.ObservedGeneration).To(Equal(plan.Generation))
+		Expect(plan.Status.Moves).To(BeEmpty())
+	})
+
+	It("should execute the approved moves", func() {
+		plan := state.GetRebalancePlan("plan")
+		instance := state.GetInstance("first")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		approve(plan)
+
+		// start the migration of the instance
+		result := testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseExecuting))
+		Expect(plan.Status.Moves[0].Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseMigrating))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Spec.ServiceTargetConfigRef.Name).To(Equal("empty"))
+		Expect(instance.Annotations).To(HaveKeyWithValue(lssv1alpha1.LandscaperServiceOperationAnnotation, lssv1alpha1.LandscaperServiceOperationMigrate))
+
+		// wait for the migration
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseExecuting))
+		Expect(plan.Status.Moves[0].Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseMigrating))
+
+		// finish the migration, as done by the instance controller
+		instance.Status.ServiceTargetConfigRef = instance.Spec.ServiceTargetConfigRef.DeepCopy()
+		Expect(testenv.Client.Status().Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseCompleted))
+		Expect(plan.Status.CompletionTime).ToNot(BeNil())
+		Expect(plan.Status.Moves[0].Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseSucceeded))
+	})
+
+	It("should not execute a plan which has been approved before it has been computed", func() {
+		plan := state.GetRebalancePlan("plan")
+		instance := state.GetInstance("first")
+
+		approve(plan)
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhasePlanned))
+		Expect(plan.Status.Moves).To(HaveLen(1))
+		Expect(plan.Status.Message).To(ContainSubstring("approved before"))
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhasePlanned))
+		Expect(plan.Status.Moves[0].Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseProposed))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Spec.ServiceTargetConfigRef.Name).To(Equal("crowded"))
+
+		// the approval takes effect when it is granted again after the review
+		plan.Spec.Approved = false
+		Expect(testenv.Client.Update(ctx, plan)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		approve(plan)
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseExecuting))
+		Expect(plan.Status.Moves[0].Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseMigrating))
+	})
+
+	It("should skip moves whose destination is not eligible anymore", func() {
+		plan := state.GetRebalancePlan("plan")
+		instance := state.GetInstance("first")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		approve(plan)
+
+		destination := state.GetConfig("empty")
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(destination), destination)).To(Succeed())
+		destination.Spec.MaxInstances = ptr.To[int64](0)
+		Expect(testenv.Client.Update(ctx, destination)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseCompleted))
+		Expect(plan.Status.Moves[0].Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseSkipped))
+		Expect(plan.Status.Moves[0].Message).To(ContainSubstring("filtered"))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Spec.ServiceTargetConfigRef.Name).To(Equal("crowded"))
+	})
+
+	It("should skip moves for which the plan is outdated", func() {
+		plan := state.GetRebalancePlan("plan")
+		instance := state.GetInstance("first")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		approve(plan)
+
+		Expect(testenv.Client.Delete(ctx, state.GetConfig("empty"))).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseCompleted))
+		Expect(plan.Status.Moves[0].Phase).To(Equal(lssv1alpha1.RebalanceMovePhaseSkipped))
+		Expect(plan.Status.Moves[0].Message).To(ContainSubstring("does not exist anymore"))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Spec.ServiceTargetConfigRef.Name).To(Equal("crowded"))
+	})
+
+	It("should pause the execution if the approval is revoked", func() {
+		plan := state.GetRebalancePlan("plan")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		approve(plan)
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		plan.Spec.Approved = false
+		Expect(testenv.Client.Update(ctx, plan)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(plan))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(plan), plan)).To(Succeed())
+		Expect(plan.Status.Phase).To(Equal(lssv1alpha1.RebalancePlanPhaseExecuting))
+		Expect(plan.Status.Moves).To(HaveLen(1))
+		Expect(plan.Status.Message).To(ContainSubstring("paused"))
+	})
+})
@@ -20,7 +20,7 @@ import (
 // AddControllerToManager adds the controller to the manager
 func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.LandscaperServiceConfiguration) error {
 	log := logger.Reconciles("serviceTargetConfig", "ServiceTargetConfig")
-	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), config)
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("landscaper-service-servicetargetconfigs"), config)
 	if err != nil {
 		return err
 	}
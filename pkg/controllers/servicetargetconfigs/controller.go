@@ -6,34 +6,65 @@ package servicetargetconfigs
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"reflect"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	kutils "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 
 	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
 	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
 // Controller is the servicetargetconfig controller
 type Controller struct {
 	operation.Operation
 	log logging.Logger
+
+	// Prober probes the target clusters of the service target configs.
+	Prober TargetClusterProber
+}
+
+// NewTestActuator creates a new controller for testing purposes.
+func NewTestActuator(op operation.Operation, logger logging.Logger) *Controller {
+	return &Controller{
+		Operation: op,
+		log:       logger,
+		Prober: &TestProber{
+			Result: ProbeResult{KubernetesVersion: "v1.30.0"},
+		},
+	}
 }
 
 // NewController returns a new servicetargetconfig controller
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log: logger,
 	}
 	op := operation.NewOperation(c, scheme, config)
+	op.SetEventRecorder(eventRecorder)
 	ctrl.Operation = *op
+
+	timeout := config.ServiceTargetConfigProbe.Timeout.Duration
+	if timeout == 0 {
+		timeout = DefaultProbeTimeout
+	}
+	ctrl.Prober = &DefaultProber{
+		Timeout:  timeout,
+		Resolver: net.DefaultResolver,
+	}
 	return ctrl, nil
 }
 
@@ -51,6 +82,7 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	}
 
 	c.Operation.Scheme().Default(config)
+	errHdl := c.handleErrorFunc(config)
 
 	// update observed generation
 	if config.Status.ObservedGeneration < config.GetGeneration() {
@@ -69,14 +101,39 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
+	// reconcile delete
 	if !config.DeletionTimestamp.IsZero() {
-		// TODO: handle delete
-		controllerutil.RemoveFinalizer(config, lssv1alpha1.LandscaperServiceFinalizer)
-		if err := c.Client().Update(ctx, config); err != nil {
-			return reconcile.Result{}, err
-		}
-
+		return reconcile.Result{}, errHdl(ctx, c.handleDelete(ctx, config))
 	}
 
-	return reconcile.Result{}, c.reconcile(ctx, config)
+	result, err := c.reconcile(ctx, config)
+	return result, errHdl(ctx, err)
+}
+
+// handleErrorFunc updates the error status of a service target config
+func (c *Controller) handleErrorFunc(config *lssv1alpha1.ServiceTargetConfig) func(ctx context.Context, err error) error {
+	old := config.DeepCopy()
+	return func(ctx context.Context, err error) error {
+		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(config).String()})
+		config.Status.LastError = lsserrors.TryUpdateError(config.Status.LastError, err)
+		utils.RecordErrorEvent(c.EventRecorder(), config, "ReconcileFailed", err)
+		utils.RecordConditionEvents(c.EventRecorder(), config, old.Status.Conditions, config.Status.Conditions)
+
+		if !reflect.DeepEqual(old.Status, config.Status) {
+			if err2 := c.Client().Status().Update(ctx, config); err2 != nil {
+				if apierrors.IsConflict(err2) {
+					// reduce logging
+					logger.Info(fmt.Sprintf("unable to update status: %s", err2.Error()))
+				} else {
+					logger.Error(err2, "unable to update status")
+				}
+
+				// retry on conflict
+				if err != nil {
+					return err2
+				}
+			}
+		}
+		return err
+	}
 }
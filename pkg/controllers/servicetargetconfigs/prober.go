@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package servicetargetconfigs
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/controllers/healthwatcher"
+)
+
+// ProbeResult is the result of the probe of a target cluster.
+type ProbeResult struct {
+	// KubernetesVersion is the version of the API server of the target cluster.
+	KubernetesVersion string
+	// ReachableError is set if the API server of the target cluster is not reachable.
+	ReachableError error
+	// CredentialsError is set if the credentials do not allow to create namespaces and cluster roles.
+	CredentialsError error
+	// IngressDomainError is set if the ingress domain can not be resolved.
+	IngressDomainError error
+	// Capacity is the capacity of the nodes of the target cluster. It is nil if the nodes could not be listed.
+	Capacity *NodeCapacity
+}
+
+// NodeCapacity is the summed capacity of the nodes of a target cluster.
+type NodeCapacity struct {
+	// NodeCount is the number of nodes.
+	NodeCount int
+	// AllocatableCPU is the sum of the allocatable cpu of all nodes.
+	AllocatableCPU resource.Quantity
+	// AllocatableMemory is the sum of the allocatable memory of all nodes.
+	AllocatableMemory resource.Quantity
+}
+
+// TargetClusterProber probes the target cluster of a service target config.
+type TargetClusterProber interface {
+	// Probe probes the target cluster with the kubeconfig of the given secret.
+	Probe(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig, secret *corev1.Secret) *ProbeResult
+}
+
+// requiredPermission is a permission that the credentials of a target cluster must grant.
+type requiredPermission struct {
+	group    string
+	resource string
+}
+
+var requiredPermissions = []requiredPermission{
+	{group: "", resource: "namespaces"},
+	{group: "rbac.authorization.k8s.io", resource: "clusterroles"},
+	{group: "rbac.authorization.k8s.io", resource: "clusterrolebindings"},
+}
+
+// ingressProbeHost is the host name which is resolved in the ingress domain.
+// The ingress domain of a target cluster is a wildcard domain, so that any host name must be resolvable.
+const ingressProbeHost = "probe"
+
+// DefaultProber probes a target cluster by building a client from the kubeconfig secret of the service target config.
+type DefaultProber struct {
+	// Timeout is the timeout of a single probe.
+	Timeout time.Duration
+	// Resolver is used to resolve the ingress domain.
+	Resolver *net.Resolver
+}
+
+// Probe probes the target cluster with the kubeconfig of the given secret.
+func (p *DefaultProber) Probe(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig, secret *corev1.Secret) *ProbeResult {
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout)
+	defer cancel()
+
+	result := &ProbeResult{}
+
+	restConfig, _, _, err := healthwatcher.GetKubeClientFromSecret(*secret, config.Spec.SecretRef.Key)
+	if err != nil {
+		result.ReachableError = fmt.Errorf("unable to build client from kubeconfig: %w", err)
+		return result
+	}
+
+	restConfig.Timeout = p.Timeout
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		result.ReachableError = fmt.Errorf("unable to build client from kubeconfig: %w", err)
+		return result
+	}
+
+	version, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		result.ReachableError = fmt.Errorf("unable to get server version: %w", err)
+		return result
+	}
+	result.KubernetesVersion = version.GitVersion
+
+	result.CredentialsError = checkPermissions(ctx, clientset)
+	result.IngressDomainError = p.checkIngressDomain(ctx, config.Spec.IngressDomain)
+	result.Capacity = getNodeCapacity(ctx, clientset)
+
+	return result
+}
+
+// getNodeCapacity returns the summed capacity of the nodes of the target cluster, or nil if the nodes could not be listed.
+func getNodeCapacity(ctx context.Context, clientset kubernetes.Interface) *NodeCapacity {
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil
+	}
+
+	capacity := &NodeCapacity{
+		NodeCount: len(nodes.Items),
+	}
+	for i := range nodes.Items {
+		allocatable := nodes.Items[i].Status.Allocatable
+		capacity.AllocatableCPU.Add(allocatable[corev1.ResourceCPU])
+		capacity.AllocatableMemory.Add(allocatable[corev1.ResourceMemory])
+	}
+
+	return capacity
+}
+
+// checkPermissions checks that the credentials allow to create the resources that are needed to deploy instances.
+func checkPermissions(ctx context.Context, clientset kubernetes.Interface) error {
+	for _, permission := range requiredPermissions {
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Verb:     "create",
+					Group:    permission.group,
+					Resource: permission.resource,
+				},
+			},
+		}
+
+		review, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to review access to %s: %w", permission.resource, err)
+		}
+		if !review.Status.Allowed {
+			return fmt.Errorf("not allowed to create %s", permission.resource)
+		}
+	}
+
+	return nil
+}
+
+// checkIngressDomain checks that the ingress domain is set and resolvable.
+func (p *DefaultProber) checkIngressDomain(ctx context.Context, ingressDomain string) error {
+	if len(ingressDomain) == 0 {
+		return fmt.Errorf("ingress domain is not set")
+	}
+
+	host := fmt.Sprintf("%s.%s", ingressProbeHost, ingressDomain)
+	if _, err := p.Resolver.LookupHost(ctx, host); err != nil {
+		return fmt.Errorf("unable to resolve ingress domain %q: %w", ingressDomain, err)
+	}
+
+	return nil
+}
+
+// TestProber is a prober for testing purposes, which returns a fixed result.
+type TestProber struct {
+	Result ProbeResult
+}
+
+// Probe returns the fixed result of the test prober.
+func (p *TestProber) Probe(_ context.Context, _ *lssv1alpha1.ServiceTargetConfig, _ *corev1.Secret) *ProbeResult {
+	result := p.Result
+	return &result
+}
@@ -6,11 +6,271 @@ package servicetargetconfigs
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+const (
+	// DrainRequeueDuration is the duration after which a draining service target config is reconciled again.
+	DrainRequeueDuration = time.Second * 30
 )
 
 // reconcile reconciles a service target config.
-func (c *Controller) reconcile(_ context.Context, _ *lssv1alpha1.ServiceTargetConfig) error {
+func (c *Controller) reconcile(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig) (reconcile.Result, error) {
+	currOp := "Reconcile"
+
+	nextProbe, err := c.reconcileProbe(ctx, config)
+	if err != nil {
+		return reconcile.Result{}, lsserrors.NewWrappedError(err, currOp, "ProbeTargetCluster", err.Error())
+	}
+
+	if err := c.reconcileUsage(ctx, config); err != nil {
+		return reconcile.Result{}, lsserrors.NewWrappedError(err, currOp, "AggregateUsage", err.Error())
+	}
+
+	if !utils.HasOperationAnnotation(config, lssv1alpha1.LandscaperServiceOperationDrain) {
+		return reconcile.Result{RequeueAfter: nextProbe}, nil
+	}
+
+	result, err := c.reconcileDrain(ctx, config)
+	if err != nil {
+		return reconcile.Result{}, lsserrors.NewWrappedError(err, currOp, "Drain", err.Error())
+	}
+	if result.RequeueAfter == 0 || nextProbe < result.RequeueAfter {
+		result.RequeueAfter = nextProbe
+	}
+	return result, nil
+}
+
+// reconcileDrain migrates the instances of a service target config with drain annotation to other service target configs.
+// The service target config is cordoned first, so that no new instances are scheduled on it.
+// The instances are migrated one after the other. The next migration is started when the previous one has finished.
+func (c *Controller) reconcileDrain(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(config).String()},
+		lc.KeyMethod, "reconcileDrain")
+
+	if !config.Spec.Cordoned {
+		logger.Info("Cordoning service target config")
+		config.Spec.Cordoned = true
+		if err := c.Client().Update(ctx, config); err != nil {
+			return reconcile.Result{}, fmt.Errorf("unable to cordon service target config: %w", err)
+		}
+		c.EventRecorder().Event(config, corev1.EventTypeNormal, "Cordoned", "service target config has been cordoned for the drain")
+		return reconcile.Result{}, nil
+	}
+
+	old := config.DeepCopy()
+
+	if config.Status.Drain == nil || config.Status.Drain.Phase == lssv1alpha1.ServiceTargetConfigDrainPhaseDrained {
+		logger.Info("Starting drain of service target config")
+		config.Status.Drain = &lssv1alpha1.ServiceTargetConfigDrainStatus{
+			Phase:     lssv1alpha1.ServiceTargetConfigDrainPhaseDraining,
+			StartTime: metav1.Now(),
+		}
+		c.EventRecorder().Eventf(config, corev1.EventTypeNormal, "DrainStarted",
+			"draining %d instance(s)", len(config.Status.InstanceRefs))
+	}
+
+	drain := config.Status.Drain
+	drain.RemainingInstances = append([]lssv1alpha1.ObjectReference{}, config.Status.InstanceRefs...)
+	drain.MigratingInstance = nil
+
+	if len(drain.RemainingInstances) == 0 {
+		now := metav1.Now()
+		drain.Phase = lssv1alpha1.ServiceTargetConfigDrainPhaseDrained
+		drain.CompletionTime = &now
+		drain.Message = "all instances have been migrated"
+		if err := c.updateStatus(ctx, old, config); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		logger.Info("Drain of service target config has finished")
+		c.EventRecorder().Event(config, corev1.EventTypeNormal, "Drained", drain.Message)
+
+		utils.RemoveOperationAnnotation(config)
+		if err := c.Client().Update(ctx, config); err != nil {
+			return reconcile.Result{}, fmt.Errorf("unable to remove drain operation annotation: %w", err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	instanceList := &lssv1alpha1.InstanceList{}
+	if err := c.Client().List(ctx, instanceList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to list instances: %w", err)
+	}
+
+	if migrating := findMigratingInstance(config, instanceList.Items); migrating != nil {
+		drain.MigratingInstance = &lssv1alpha1.ObjectReference{
+			Name:      migrating.Name,
+			Namespace: migrating.Namespace,
+		}
+		drain.Message = fmt.Sprintf("migrating instance %s to service target config %s",
+			drain.MigratingInstance.NamespacedName().String(), migrating.Spec.ServiceTargetConfigRef.NamespacedName().String())
+		return reconcile.Result{RequeueAfter: DrainRequeueDuration}, c.updateStatus(ctx, old, config)
+	}
+
+	migrating, destination, failures, err := c.startNextMigration(ctx, config, instanceList.Items)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if migrating != nil {
+		drain.MigratingInstance = migrating
+		drain.Message = fmt.Sprintf("migrating instance %s to service target config %s",
+			migrating.NamespacedName().String(), destination.NamespacedName().String())
+	} else {
+		drain.Message = fmt.Sprintf("unable to migrate the remaining instances: %s", strings.Join(failures, "; "))
+		c.EventRecorder().Event(config, corev1.EventTypeWarning, "DrainStalled", drain.Message)
+	}
+
+	return reconcile.Result{RequeueAfter: DrainRequeueDuration}, c.updateStatus(ctx, old, config)
+}
+
+// startNextMigration starts the migration of the first remaining instance that can be migrated to another service target config.
+// It returns the migrating instance and its destination, or the reasons why none of the remaining instances can be migrated.
+func (c *Controller) startNextMigration(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig,
+	instances []lssv1alpha1.Instance) (*lssv1alpha1.ObjectReference, *lssv1alpha1.ObjectReference, []string, error) {
+
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(config).String()},
+		lc.KeyMethod, "startNextMigration")
+
+	serviceTargetConfigs, err := lssscheduling.GetVisibleServiceTargetConfigs(ctx, c.Client())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	serviceTargetConfigs = removeServiceTargetConfig(serviceTargetConfigs, config)
+
+	scheduling, err := lssscheduling.GetMergedScheduling(ctx, c.Client(), c.Config().Scheduling, c.Config().SchedulingSelector)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	failures := make([]string, 0)
+
+	for i := range config.Status.Drain.RemainingInstances {
+		instanceRef := &config.Status.Drain.RemainingInstances[i]
+
+		instance := findInstance(instances, instanceRef)
+		if instance == nil {
+			failures = append(failures, fmt.Sprintf("instance %s does not exist", instanceRef.NamespacedName().String()))
+			continue
+		}
+
+		if utils.HasOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationIgnore) {
+			failures = append(failures, fmt.Sprintf("instance %s has ignore annotation", instanceRef.NamespacedName().String()))
+			continue
+		}
+
+		if instance.Status.ServiceTargetConfigRef == nil {
+			failures = append(failures, fmt.Sprintf("instance %s has not been reconciled yet", instanceRef.NamespacedName().String()))
+			continue
+		}
+
+		deployment, err := c.getOwningDeployment(ctx, instance)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if deployment == nil {
+			failures = append(failures, fmt.Sprintf("instance %s has no landscaper deployment", instanceRef.NamespacedName().String()))
+			continue
+		}
+
+		winner, _, err := lssscheduling.Schedule(scheduling.Scheduling, deployment, serviceTargetConfigs, instances)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("instance %s: %s", instanceRef.NamespacedName().String(), err.Error()))
+			continue
+		}
+
+		destination := &lssv1alpha1.ObjectReference{
+			Name:      winner.Name,
+			Namespace: winner.Namespace,
+		}
+
+		logger.Info("Starting migration of instance", lc.KeyResource, instanceRef.NamespacedName().String(),
+			"destination", destination.NamespacedName().String())
+
+		instance.Spec.ServiceTargetConfigRef = *destination
+		utils.SetOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationMigrate)
+		if err := c.Client().Update(ctx, instance); err != nil {
+			return nil, nil, nil, fmt.Errorf("unable to start migration of instance %s: %w", instanceRef.NamespacedName().String(), err)
+		}
+		c.EventRecorder().Eventf(config, corev1.EventTypeNormal, "MigrationStarted",
+			"migrating instance %s to service target config %s", instanceRef.NamespacedName().String(), destination.NamespacedName().String())
+
+		return instanceRef, destination, nil, nil
+	}
+
+	return nil, nil, failures, nil
+}
+
+// getOwningDeployment returns the landscaper deployment which owns the instance, or nil if there is no such deployment.
+func (c *Controller) getOwningDeployment(ctx context.Context, instance *lssv1alpha1.Instance) (*lssv1alpha1.LandscaperDeployment, error) {
+	ownerRef := metav1.GetControllerOf(instance)
+	if ownerRef == nil || ownerRef.Kind != "LandscaperDeployment" || ownerRef.APIVersion != lssv1alpha1.SchemeGroupVersion.String() {
+		return nil, nil
+	}
+
+	deployment := &lssv1alpha1.LandscaperDeployment{}
+	if err := c.Client().Get(ctx, client.ObjectKey{Name: ownerRef.Name, Namespace: instance.Namespace}, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get landscaper deployment of instance %s: %w", client.ObjectKeyFromObject(instance).String(), err)
+	}
+
+	return deployment, nil
+}
+
+func (c *Controller) updateStatus(ctx context.Context, old, config *lssv1alpha1.ServiceTargetConfig) error {
+	if !reflect.DeepEqual(old.Status, config.Status) {
+		if err := c.Client().Status().Update(ctx, config); err != nil {
+			return fmt.Errorf("unable to update service target config status: %w", err)
+		}
+	}
 	return nil
 }
+
+// findMigratingInstance returns an instance which is being migrated away from the given service target config, if any.
+func findMigratingInstance(config *lssv1alpha1.ServiceTargetConfig, instances []lssv1alpha1.Instance) *lssv1alpha1.Instance {
+	for i := range instances {
+		instance := &instances[i]
+		if instance.IsMigrating() && instance.Status.ServiceTargetConfigRef.IsObject(config) {
+			return instance
+		}
+	}
+	return nil
+}
+
+func findInstance(instances []lssv1alpha1.Instance, ref *lssv1alpha1.ObjectReference) *lssv1alpha1.Instance {
+	for i := range instances {
+		if ref.IsObject(&instances[i]) {
+			return &instances[i]
+		}
+	}
+	return nil
+}
+
+func removeServiceTargetConfig(configs []lssv1alpha1.ServiceTargetConfig, config *lssv1alpha1.ServiceTargetConfig) []lssv1alpha1.ServiceTargetConfig {
+	result := make([]lssv1alpha1.ServiceTargetConfig, 0, len(configs))
+	for i := range configs {
+		if configs[i].Name != config.Name || configs[i].Namespace != config.Namespace {
+			result = append(result, configs[i])
+		}
+	}
+	return result
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package servicetargetconfigs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	"github.com/gardener/landscaper-service/pkg/metrics"
+)
+
+// handleDelete handles the deletion of service target configs.
+// The deletion is blocked as long as instances are deployed on the target cluster,
+// unless the on-delete-strategy annotation is set to orphan-instances. In this case the instances are marked as orphaned.
+// Instances which are being migrated to another service target config are never orphaned,
+// the deletion is blocked until their migration has finished.
+func (c *Controller) handleDelete(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(config).String()},
+		lc.KeyMethod, "handleDelete")
+
+	curOp := "Delete"
+
+	instances, err := c.getReferencedInstances(ctx, config)
+	if err != nil {
+		return lsserrors.NewWrappedError(err, curOp, "GetInstances", err.Error())
+	}
+
+	if len(instances) > 0 {
+		if config.Annotations[lssv1alpha1.LandscaperServiceOnDeleteStrategyAnnotation] != lssv1alpha1.LandscaperServiceOnDeleteStrategyOrphanInstances {
+			names := make([]string, 0, len(instances))
+			for i := range instances {
+				names = append(names, client.ObjectKeyFromObject(&instances[i]).String())
+			}
+			msg := fmt.Sprintf("deletion is blocked by %d instance(s): %s", len(instances), strings.Join(names, ", "))
+			return lsserrors.NewError(curOp, "DeletionBlocked", msg)
+		}
+
+		migrating := make([]string, 0)
+		for i := range instances {
+			if !instances[i].Spec.ServiceTargetConfigRef.IsObject(config) {
+				migrating = append(migrating, client.ObjectKeyFromObject(&instances[i]).String())
+			}
+		}
+		if len(migrating) > 0 {
+			msg := fmt.Sprintf("deletion is blocked by %d instance(s) which are being migrated to another service target config: %s",
+				len(migrating), strings.Join(migrating, ", "))
+			return lsserrors.NewError(curOp, "DeletionBlocked", msg)
+		}
+
+		for i := range instances {
+			instance := &instances[i]
+			logger.Info("Marking instance as orphaned", lc.KeyResource, client.ObjectKeyFromObject(instance).String())
+
+			metav1.SetMetaDataAnnotation(&instance.ObjectMeta, lssv1alpha1.LandscaperServiceOrphanedAnnotation, client.ObjectKeyFromObject(config).String())
+			if err := c.Client().Update(ctx, instance); err != nil {
+				return lsserrors.NewWrappedError(err, curOp, "OrphanInstance", err.Error())
+			}
+		}
+
+		c.EventRecorder().Eventf(config, corev1.EventTypeNormal, "InstancesOrphaned",
+			"%d instance(s) have been marked as orphaned", len(instances))
+	}
+
+	controllerutil.RemoveFinalizer(config, lssv1alpha1.LandscaperServiceFinalizer)
+	if err := c.Client().Update(ctx, config); err != nil {
+		return lsserrors.NewWrappedError(err, curOp, "RemoveFinalizer", err.Error())
+	}
+
+	metrics.ObserveDeletionDuration("ServiceTargetConfig", config)
+	metrics.DeleteServiceTargetConfigMetrics(config.Namespace, config.Name)
+	return nil
+}
+
+// getReferencedInstances returns the existing instances from the instance references of the service target config.
+func (c *Controller) getReferencedInstances(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig) ([]lssv1alpha1.Instance, error) {
+	instances := make([]lssv1alpha1.Instance, 0, len(config.Status.InstanceRefs))
+
+	for _, ref := range config.Status.InstanceRefs {
+		instance := &lssv1alpha1.Instance{}
+		if err := c.Client().Get(ctx, ref.NamespacedName(), instance); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("unable to get instance %s: %w", ref.NamespacedName().String(), err)
+		}
+		instances = append(instances, *instance)
+	}
+
+	return instances, nil
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package servicetargetconfigs_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	servicetargetconfigscontroller "github.com/gardener/landscaper-service/pkg/controllers/servicetargetconfigs"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	testutils "github.com/gardener/landscaper-service/test/utils"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+var _ = Describe("Delete", func() {
+	var (
+		op    *operation.Operation
+		ctrl  *servicetargetconfigscontroller.Controller
+		ctx   context.Context
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		op = operation.NewOperation(testenv.Client, envtest.LandscaperServiceScheme, testutils.DefaultControllerConfiguration())
+		ctrl = servicetargetconfigscontroller.NewTestActuator(*op, logging.Discard())
+	})
+
+	AfterEach(func() {
+		defer ctx.Done()
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should block the deletion while instances are deployed", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/delete/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		config := state.GetConfig("default")
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(kutil.HasFinalizer(config, lssv1alpha1.LandscaperServiceFinalizer)).To(BeTrue())
+
+		Expect(testenv.Client.Delete(ctx, config)).To(Succeed())
+		testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(kutil.HasFinalizer(config, lssv1alpha1.LandscaperServiceFinalizer)).To(BeTrue())
+		Expect(config.Status.LastError).ToNot(BeNil())
+		Expect(config.Status.LastError.Reason).To(Equal("DeletionBlocked"))
+		Expect(config.Status.LastError.Message).To(ContainSubstring(kutil.ObjectKeyFromObject(instance).String()))
+
+		Expect(testenv.Client.Delete(ctx, instance)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(testenv.WaitForObjectToBeDeleted(ctx, testenv.Client, config, 5*time.Second)).To(Succeed())
+	})
+
+	It("should mark the instances as orphaned with the orphan-instances strategy", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/delete/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		config := state.GetConfig("default")
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+
+		Expect(testenv.Client.Delete(ctx, config)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(testenv.WaitForObjectToBeDeleted(ctx, testenv.Client, config, 5*time.Second)).To(Succeed())
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Annotations).To(HaveKeyWithValue(lssv1alpha1.LandscaperServiceOrphanedAnnotation, kutil.ObjectKeyFromObject(config).String()))
+	})
+
+	It("should not orphan the instances which are being migrated to another service target config", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/delete/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		config := state.GetConfig("default")
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+
+		// the instance is being migrated away from the service target config
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		instance.Spec.ServiceTargetConfigRef.Name = "destination"
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		Expect(testenv.Client.Delete(ctx, config)).To(Succeed())
+		testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+		Expect(config.Status.LastError).ToNot(BeNil())
+		Expect(config.Status.LastError.Reason).To(Equal("DeletionBlocked"))
+		Expect(config.Status.LastError.Message).To(ContainSubstring("being migrated"))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Annotations).ToNot(HaveKey(lssv1alpha1.LandscaperServiceOrphanedAnnotation))
+
+		// the migration has finished
+		config.Status.InstanceRefs = nil
+		Expect(testenv.Client.Status().Update(ctx, config)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(testenv.WaitForObjectToBeDeleted(ctx, testenv.Client, config, 5*time.Second)).To(Succeed())
+	})
+})
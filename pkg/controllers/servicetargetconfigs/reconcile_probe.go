@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package servicetargetconfigs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+const (
+	// DefaultProbeInterval is the duration between two probes of a target cluster, if not configured otherwise.
+	DefaultProbeInterval = time.Minute * 5
+	// DefaultProbeTimeout is the timeout of a single probe of a target cluster, if not configured otherwise.
+	DefaultProbeTimeout = time.Second * 30
+)
+
+// probeInterval returns the configured duration between two probes of a target cluster.
+func (c *Controller) probeInterval() time.Duration {
+	if interval := c.Config().ServiceTargetConfigProbe.Interval.Duration; interval > 0 {
+		return interval
+	}
+	return DefaultProbeInterval
+}
+
+// reconcileProbe probes the target cluster of a service target config and records the result in the status conditions.
+// The target cluster is probed again when the probe interval has passed, or when the spec has changed.
+// It returns the duration after which the next probe is due.
+func (c *Controller) reconcileProbe(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig) (time.Duration, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(config).String()},
+		lc.KeyMethod, "reconcileProbe")
+
+	interval := c.probeInterval()
+
+	ready := meta.FindStatusCondition(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReady)
+	if config.Status.LastProbeTime != nil && ready != nil && ready.ObservedGeneration == config.GetGeneration() {
+		if elapsed := time.Since(config.Status.LastProbeTime.Time); elapsed < interval {
+			return interval - elapsed, nil
+		}
+	}
+
+	logger.Debug("Probing target cluster")
+
+	old := config.DeepCopy()
+
+	result, err := c.probe(ctx, config)
+	if err != nil {
+		return 0, err
+	}
+
+	now := metav1.Now()
+	config.Status.LastProbeTime = &now
+	config.Status.KubernetesVersion = result.KubernetesVersion
+	setProbeConditions(config, result)
+	setNodeCapacity(config, result.Capacity)
+
+	if err := c.updateStatus(ctx, old, config); err != nil {
+		return 0, err
+	}
+
+	return interval, nil
+}
+
+// probe loads the kubeconfig secret of the service target config and probes the target cluster.
+func (c *Controller) probe(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig) (*ProbeResult, error) {
+	secret := &corev1.Secret{}
+	secretKey := client.ObjectKey{Name: config.Spec.SecretRef.Name, Namespace: config.Spec.SecretRef.Namespace}
+	if err := c.Client().Get(ctx, secretKey, secret); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("unable to get kubeconfig secret %s: %w", secretKey.String(), err)
+		}
+		return &ProbeResult{
+			ReachableError: fmt.Errorf("kubeconfig secret %s does not exist", secretKey.String()),
+		}, nil
+	}
+
+	return c.Prober.Probe(ctx, config, secret), nil
+}
+
+// setNodeCapacity records the capacity of the nodes of the target cluster in the usage status of the service target config.
+// If the capacity is unknown, the previously recorded capacity is kept.
+func setNodeCapacity(config *lssv1alpha1.ServiceTargetConfig, capacity *NodeCapacity) {
+	if capacity == nil {
+		return
+	}
+
+	if config.Status.Usage == nil {
+		config.Status.Usage = &lssv1alpha1.ServiceTargetConfigUsage{}
+	}
+	config.Status.Usage.NodeCount = ptr.To(capacity.NodeCount)
+	config.Status.Usage.AllocatableCPU = ptr.To(capacity.AllocatableCPU.DeepCopy())
+	config.Status.Usage.AllocatableMemory = ptr.To(capacity.AllocatableMemory.DeepCopy())
+}
+
+// setProbeConditions sets the status conditions of the service target config according to the probe result.
+func setProbeConditions(config *lssv1alpha1.ServiceTargetConfig, result *ProbeResult) {
+	generation := config.GetGeneration()
+
+	reachable := metav1.Condition{
+		Type:               lssv1alpha1.ServiceTargetConfigConditionReachable,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             "APIServerReachable",
+		Message:            fmt.Sprintf("kubernetes version %s", result.KubernetesVersion),
+	}
+	credentials := metav1.Condition{
+		Type:               lssv1alpha1.ServiceTargetConfigConditionCredentialsValid,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             "PermissionsGranted",
+		Message:            "the credentials allow to create namespaces and cluster roles",
+	}
+	ready := metav1.Condition{
+		Type:               lssv1alpha1.ServiceTargetConfigConditionReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             "TargetClusterReady",
+		Message:            "the target cluster is ready for new instances",
+	}
+
+	switch {
+	case result.ReachableError != nil:
+		reachable.Status = metav1.ConditionFalse
+		reachable.Reason = "APIServerUnreachable"
+		reachable.Message = result.ReachableError.Error()
+		credentials.Status = metav1.ConditionUnknown
+		credentials.Reason = "APIServerUnreachable"
+		credentials.Message = "the credentials can not be checked"
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = reachable.Reason
+		ready.Message = reachable.Message
+	case result.CredentialsError != nil:
+		credentials.Status = metav1.ConditionFalse
+		credentials.Reason = "InsufficientPermissions"
+		credentials.Message = result.CredentialsError.Error()
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = credentials.Reason
+		ready.Message = credentials.Message
+	case result.IngressDomainError != nil:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = "IngressDomainNotResolvable"
+		ready.Message = result.IngressDomainError.Error()
+	}
+
+	meta.SetStatusCondition(&config.Status.Conditions, reachable)
+	meta.SetStatusCondition(&config.Status.Conditions, credentials)
+	meta.SetStatusCondition(&config.Status.Conditions, ready)
+}
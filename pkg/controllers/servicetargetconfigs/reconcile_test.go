@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package servicetargetconfigs_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	servicetargetconfigscontroller "github.com/gardener/landscaper-service/pkg/controllers/servicetargetconfigs"
+	"github.com/gardener/landscaper-service/pkg/metrics"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	testutils "github.com/gardener/landscaper-service/test/utils"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+var _ = Describe("Reconcile", func() {
+	var (
+		op    *operation.Operation
+		ctrl  *servicetargetconfigscontroller.Controller
+		ctx   context.Context
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		op = operation.NewOperation(testenv.Client, envtest.LandscaperServiceScheme, testutils.DefaultControllerConfiguration())
+		ctrl = servicetargetconfigscontroller.NewTestActuator(*op, logging.Discard())
+	})
+
+	AfterEach(func() {
+		defer ctx.Done()
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should drain a service target config", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		source := state.GetConfig("source")
+		instance := state.GetInstance("test")
+
+		// set finalizer
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(source))
+		// cordon
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(source))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(source), source)).To(Succeed())
+		Expect(source.Spec.Cordoned).To(BeTrue())
+
+		// start the migration of the instance
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(source))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(source), source)).To(Succeed())
+		Expect(source.Status.Drain).ToNot(BeNil())
+		Expect(source.Status.Drain.Phase).To(Equal(lssv1alpha1.ServiceTargetConfigDrainPhaseDraining))
+		Expect(source.Status.Drain.RemainingInstances).To(HaveLen(1))
+		Expect(source.Status.Drain.MigratingInstance).ToNot(BeNil())
+		Expect(source.Status.Drain.MigratingInstance.Name).To(Equal(instance.Name))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Spec.ServiceTargetConfigRef.Name).To(Equal("destination"))
+		Expect(instance.Annotations).To(HaveKeyWithValue(lssv1alpha1.LandscaperServiceOperationAnnotation, lssv1alpha1.LandscaperServiceOperationMigrate))
+
+		// wait for the migration
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(source))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(source), source)).To(Succeed())
+		Expect(source.Status.Drain.Phase).To(Equal(lssv1alpha1.ServiceTargetConfigDrainPhaseDraining))
+		Expect(source.Status.Drain.MigratingInstance).ToNot(BeNil())
+
+		// finish the migration, as done by the instance controller
+		instance.Status.ServiceTargetConfigRef = instance.Spec.ServiceTargetConfigRef.DeepCopy()
+		Expect(testenv.Client.Status().Update(ctx, instance)).To(Succeed())
+		source.Status.InstanceRefs = nil
+		Expect(testenv.Client.Status().Update(ctx, source)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(source))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(source), source)).To(Succeed())
+		Expect(source.Status.Drain.Phase).To(Equal(lssv1alpha1.ServiceTargetConfigDrainPhaseDrained))
+		Expect(source.Status.Drain.RemainingInstances).To(BeEmpty())
+		Expect(source.Status.Drain.CompletionTime).ToNot(BeNil())
+		Expect(source.Annotations).ToNot(HaveKey(lssv1alpha1.LandscaperServiceOperationAnnotation))
+		Expect(source.Spec.Cordoned).To(BeTrue())
+	})
+
+	It("should report the remaining instances if they cannot be migrated", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		source := state.GetConfig("source")
+		instance := state.GetInstance("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(source))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(source))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(source))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(source), source)).To(Succeed())
+
+		Expect(source.Status.Drain).ToNot(BeNil())
+		Expect(source.Status.Drain.Phase).To(Equal(lssv1alpha1.ServiceTargetConfigDrainPhaseDraining))
+		Expect(source.Status.Drain.RemainingInstances).To(ConsistOf(lssv1alpha1.ObjectReference{Name: instance.Name, Namespace: instance.Namespace}))
+		Expect(source.Status.Drain.MigratingInstance).To(BeNil())
+		Expect(source.Status.Drain.Message).To(ContainSubstring("unable to migrate the remaining instances"))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Spec.ServiceTargetConfigRef.Name).To(Equal("source"))
+	})
+
+	It("should set the conditions of a ready target cluster", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
+		Expect(err).ToNot(HaveOccurred())
+
+		config := state.GetConfig("default")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		result := testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+
+		Expect(config.Status.LastProbeTime).ToNot(BeNil())
+		Expect(config.Status.KubernetesVersion).To(Equal("v1.30.0"))
+		Expect(meta.IsStatusConditionTrue(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReachable)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionCredentialsValid)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReady)).To(BeTrue())
+	})
+
+	It("should set the conditions of a target cluster with insufficient permissions", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
+		Expect(err).ToNot(HaveOccurred())
+
+		config := state.GetConfig("default")
+		ctrl.Prober = &servicetargetconfigscontroller.TestProber{
+			Result: servicetargetconfigscontroller.ProbeResult{
+				KubernetesVersion: "v1.30.0",
+				CredentialsError:  fmt.Errorf("not allowed to create clusterroles"),
+			},
+		}
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+
+		Expect(meta.IsStatusConditionTrue(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReachable)).To(BeTrue())
+		Expect(meta.IsStatusConditionFalse(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionCredentialsValid)).To(BeTrue())
+		ready := meta.FindStatusCondition(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReady)
+		Expect(ready).ToNot(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal("InsufficientPermissions"))
+	})
+
+	It("should set the conditions if the kubeconfig secret does not exist", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
+		Expect(err).ToNot(HaveOccurred())
+
+		config := state.GetConfig("default")
+		config.Spec.SecretRef.Name = "missing"
+		Expect(testenv.Client.Update(ctx, config)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+
+		Expect(meta.IsStatusConditionFalse(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReachable)).To(BeTrue())
+		Expect(meta.IsStatusConditionFalse(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionReady)).To(BeTrue())
+		credentials := meta.FindStatusCondition(config.Status.Conditions, lssv1alpha1.ServiceTargetConfigConditionCredentialsValid)
+		Expect(credentials).ToNot(BeNil())
+		Expect(credentials.Status).To(Equal(metav1.ConditionUnknown))
+	})
+
+	It("should aggregate the usage of the target cluster", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test4")
+		Expect(err).ToNot(HaveOccurred())
+
+		config := state.GetConfig("default")
+		ctrl.Prober = &servicetargetconfigscontroller.TestProber{
+			Result: servicetargetconfigscontroller.ProbeResult{
+				KubernetesVersion: "v1.30.0",
+				Capacity: &servicetargetconfigscontroller.NodeCapacity{
+					NodeCount:         2,
+					AllocatableCPU:    resource.MustParse("4"),
+					AllocatableMemory: resource.MustParse("16Gi"),
+				},
+			},
+		}
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(config), config)).To(Succeed())
+
+		usage := config.Status.Usage
+		Expect(usage).ToNot(BeNil())
+		Expect(usage.InstanceCount).To(Equal(2))
+		Expect(usage.InstancesByPhase).To(Equal(map[string]int{"Succeeded": 1, "Failed": 1}))
+		Expect(usage.RequestedCPU.Cmp(resource.MustParse("350m"))).To(Equal(0))
+		Expect(usage.RequestedMemory.Cmp(resource.MustParse("1536Mi"))).To(Equal(0))
+		Expect(usage.NodeCount).To(PointTo(Equal(2)))
+		Expect(usage.AllocatableCPU.Cmp(resource.MustParse("4"))).To(Equal(0))
+		Expect(usage.AllocatableMemory.Cmp(resource.MustParse("16Gi"))).To(Equal(0))
+
+		Expect(promtestutil.ToFloat64(metrics.ServiceTargetConfigInstances.WithLabelValues(config.Namespace, config.Name))).To(Equal(float64(2)))
+		Expect(promtestutil.ToFloat64(metrics.ServiceTargetConfigInstancesByPhase.WithLabelValues(config.Namespace, config.Name, "Failed"))).To(Equal(float64(1)))
+		Expect(promtestutil.ToFloat64(metrics.ServiceTargetConfigRequestedCPU.WithLabelValues(config.Namespace, config.Name))).To(BeNumerically("~", 0.35))
+		Expect(promtestutil.ToFloat64(metrics.ServiceTargetConfigAllocatableMemory.WithLabelValues(config.Namespace, config.Name))).To(Equal(float64(16 * 1024 * 1024 * 1024)))
+
+		// the gauge of a phase without instances is removed
+		instance := state.GetInstance("test2")
+		instance.Status.Phase = "Succeeded"
+		Expect(testenv.Client.Status().Update(ctx, instance)).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(config))
+		Expect(promtestutil.ToFloat64(metrics.ServiceTargetConfigInstancesByPhase.WithLabelValues(config.Namespace, config.Name, "Succeeded"))).To(Equal(float64(2)))
+		Expect(metrics.ServiceTargetConfigInstancesByPhase.DeleteLabelValues(config.Namespace, config.Name, "Failed")).To(BeFalse())
+	})
+})
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package servicetargetconfigs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/metrics"
+)
+
+const (
+	// unknownPhase is used for instances which have no phase yet.
+	unknownPhase = "Unknown"
+)
+
+// reconcileUsage aggregates the usage of the target cluster by the instances of the service target config.
+// The usage is recorded in the status and exported as metrics.
+func (c *Controller) reconcileUsage(ctx context.Context, config *lssv1alpha1.ServiceTargetConfig) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(config).String()},
+		lc.KeyMethod, "reconcileUsage")
+
+	old := config.DeepCopy()
+
+	if config.Status.Usage == nil {
+		config.Status.Usage = &lssv1alpha1.ServiceTargetConfigUsage{}
+	}
+	usage := config.Status.Usage
+	usage.InstanceCount = 0
+	usage.InstancesByPhase = nil
+
+	requestedCPU := resource.Quantity{}
+	requestedMemory := resource.Quantity{}
+
+	for _, ref := range config.Status.InstanceRefs {
+		instance := &lssv1alpha1.Instance{}
+		if err := c.Client().Get(ctx, ref.NamespacedName(), instance); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("unable to get instance %s: %w", ref.NamespacedName().String(), err)
+		}
+
+		usage.InstanceCount++
+
+		phase := instance.Status.Phase
+		if len(phase) == 0 {
+			phase = unknownPhase
+		}
+		if usage.InstancesByPhase == nil {
+			usage.InstancesByPhase = make(map[string]int)
+		}
+		usage.InstancesByPhase[phase]++
+
+		if resources := instance.Spec.LandscaperConfiguration.Resources; resources != nil {
+			if err := addQuantity(&requestedCPU, resources.Requests.CPU); err != nil {
+				logger.Info("Ignoring invalid cpu request", lc.KeyResource, ref.NamespacedName().String(), lc.KeyError, err.Error())
+			}
+			if err := addQuantity(&requestedMemory, resources.Requests.Memory); err != nil {
+				logger.Info("Ignoring invalid memory request", lc.KeyResource, ref.NamespacedName().String(), lc.KeyError, err.Error())
+			}
+		}
+	}
+
+	usage.RequestedCPU = &requestedCPU
+	usage.RequestedMemory = &requestedMemory
+
+	if old.Status.Usage != nil && old.Status.Usage.RequestedCPU != nil && usage.RequestedCPU.Cmp(*old.Status.Usage.RequestedCPU) == 0 {
+		// keep the previous representation, so that the status is not updated needlessly
+		usage.RequestedCPU = old.Status.Usage.RequestedCPU
+	}
+	if old.Status.Usage != nil && old.Status.Usage.RequestedMemory != nil && usage.RequestedMemory.Cmp(*old.Status.Usage.RequestedMemory) == 0 {
+		usage.RequestedMemory = old.Status.Usage.RequestedMemory
+	}
+
+	updateUsageMetrics(config, old.Status.Usage)
+
+	return c.updateStatus(ctx, old, config)
+}
+
+// addQuantity adds the given quantity string to the sum. An empty string is ignored.
+func addQuantity(sum *resource.Quantity, value string) error {
+	if len(value) == 0 {
+		return nil
+	}
+
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return err
+	}
+
+	sum.Add(quantity)
+	return nil
+}
+
+// updateUsageMetrics exports the usage of the service target config as metrics.
+// The gauges are set in place, only the label sets which are no longer part of the usage compared to the old usage are deleted.
+func updateUsageMetrics(config *lssv1alpha1.ServiceTargetConfig, oldUsage *lssv1alpha1.ServiceTargetConfigUsage) {
+	usage := config.Status.Usage
+
+	if oldUsage != nil {
+		for phase := range oldUsage.InstancesByPhase {
+			if _, ok := usage.InstancesByPhase[phase]; !ok {
+				metrics.ServiceTargetConfigInstancesByPhase.DeleteLabelValues(config.Namespace, config.Name, phase)
+			}
+		}
+	}
+
+	metrics.ServiceTargetConfigInstances.WithLabelValues(config.Namespace, config.Name).Set(float64(usage.InstanceCount))
+	for phase, count := range usage.InstancesByPhase {
+		metrics.ServiceTargetConfigInstancesByPhase.WithLabelValues(config.Namespace, config.Name, phase).Set(float64(count))
+	}
+	setOrDeleteQuantityGauge(metrics.ServiceTargetConfigRequestedCPU, config, usage.RequestedCPU)
+	setOrDeleteQuantityGauge(metrics.ServiceTargetConfigRequestedMemory, config, usage.RequestedMemory)
+	setOrDeleteQuantityGauge(metrics.ServiceTargetConfigAllocatableCPU, config, usage.AllocatableCPU)
+	setOrDeleteQuantityGauge(metrics.ServiceTargetConfigAllocatableMemory, config, usage.AllocatableMemory)
+}
+
+// setOrDeleteQuantityGauge sets the gauge of the service target config to the given quantity or deletes it, if the quantity is not set.
+func setOrDeleteQuantityGauge(gauge *prometheus.GaugeVec, config *lssv1alpha1.ServiceTargetConfig, quantity *resource.Quantity) {
+	if quantity == nil {
+		gauge.DeleteLabelValues(config.Namespace, config.Name)
+		return
+	}
+	gauge.WithLabelValues(config.Namespace, config.Name).Set(quantity.AsApproximateFloat64())
+}
@@ -19,7 +19,7 @@ import (
 // AddControllerToManager adds the SubjectList Controller to the manager
 func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.TargetShootSidecarConfiguration) error {
 	log := logger.Reconciles("SubjectSyncController", "SubjectList")
-	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), config)
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("landscaper-service-subjectsync"), config)
 	if err != nil {
 		return err
 	}
@@ -23,10 +23,4 @@ const (
 
 	SUBJECT_LIST_NAME = "subjects"
 	LS_USER_NAMESPACE = "ls-user"
-
-	SUBJECT_LIST_ENTRY_USER            = "User"
-	SUBJECT_LIST_ENTRY_GROUP           = "Group"
-	SUBJECT_LIST_ENTRY_SERVICE_ACCOUNT = "ServiceAccount"
-
-	CUSTOM_NS_PREFIX = "cu-"
 )
@@ -10,10 +10,12 @@ import (
 
 	kutils "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -27,15 +29,16 @@ type Controller struct {
 	operation.TargetShootSidecarOperation
 	log logging.Logger
 
-	ReconcileFunc func(ctx context.Context, subjectList *lssv1alpha1.SubjectList) (reconcile.Result, error)
+	ReconcileFunc func(ctx context.Context, subjectList *lssv1alpha1.SubjectList) (reconcile.Result, bool, error)
 }
 
-func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, config *config.TargetShootSidecarConfiguration) (reconcile.Reconciler, error) {
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder, config *config.TargetShootSidecarConfiguration) (reconcile.Reconciler, error) {
 	ctrl := &Controller{
 		log: logger,
 	}
 	ctrl.ReconcileFunc = ctrl.reconcile
 	op := operation.NewTargetShootSidecarOperation(c, scheme, config)
+	op.SetEventRecorder(eventRecorder)
 	ctrl.TargetShootSidecarOperation = *op
 	return ctrl, nil
 }
@@ -77,10 +80,24 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
-	return c.reconcile(ctx, subjectList)
+	result, changed, err := c.reconcile(ctx, subjectList)
+	if err != nil {
+		if !apierrors.IsConflict(err) {
+			c.EventRecorder().Eventf(subjectList, corev1.EventTypeWarning, "SyncFailed", "unable to sync subjects: %s", err.Error())
+		}
+		return result, err
+	}
+
+	if changed {
+		c.EventRecorder().Eventf(subjectList, corev1.EventTypeNormal, "Synced",
+			"synced %d subject(s) and %d viewer subject(s) to the role bindings", len(subjectList.Spec.Subjects), len(subjectList.Spec.ViewerSubjects))
+	}
+	return result, nil
 }
 
-func (c *Controller) reconcile(ctx context.Context, subjectList *lssv1alpha1.SubjectList) (reconcile.Result, error) {
+// reconcile syncs the subjects of the subject list to the role bindings.
+// Returns true if the subjects of any role binding have changed.
+func (c *Controller) reconcile(ctx context.Context, subjectList *lssv1alpha1.SubjectList) (reconcile.Result, bool, error) {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
 	// convert subjects of the SubjectList custom resource into rbac subjects
@@ -91,30 +108,33 @@ func (c *Controller) reconcile(ctx context.Context, subjectList *lssv1alpha1.Sub
 
 	if err := userClusterRoleDef.CreateOrUpdateClusterRole(ctx, c.Client()); err != nil {
 		logger.Error(err, "failed updating user cluster role")
-		return reconcile.Result{}, err
+		return reconcile.Result{}, false, err
 	}
 
-	if err := userClusterRoleDef.CreateOrUpdateClusterRoleBinding(ctx, c.Client(), subjects); err != nil {
+	changed, err := userClusterRoleDef.CreateOrUpdateClusterRoleBinding(ctx, c.Client(), subjects)
+	if err != nil {
 		logger.Error(err, "failed updating user cluster role binding")
-		return reconcile.Result{}, err
+		return reconcile.Result{}, false, err
 	}
 
 	viewerClusterRoleDef := GetViewerClusterRoleDefinition()
 
 	if err := viewerClusterRoleDef.CreateOrUpdateClusterRole(ctx, c.Client()); err != nil {
 		logger.Error(err, "failed updating viewer cluster role")
-		return reconcile.Result{}, err
+		return reconcile.Result{}, false, err
 	}
 
-	if err := viewerClusterRoleDef.CreateOrUpdateClusterRoleBinding(ctx, c.Client(), viewerSubjects); err != nil {
+	bindingChanged, err := viewerClusterRoleDef.CreateOrUpdateClusterRoleBinding(ctx, c.Client(), viewerSubjects)
+	if err != nil {
 		logger.Error(err, "failed updating viewer cluster role binding")
-		return reconcile.Result{}, err
+		return reconcile.Result{}, false, err
 	}
+	changed = changed || bindingChanged
 
 	roleBindings := &rbacv1.RoleBindingList{}
 	if err := c.Client().List(ctx, roleBindings); err != nil {
 		logger.Error(err, "failed loading role bindings")
-		return reconcile.Result{}, err
+		return reconcile.Result{}, false, err
 	}
 
 	for _, roleBinding := range roleBindings.Items {
@@ -127,31 +147,37 @@ func (c *Controller) reconcile(ctx context.Context, subjectList *lssv1alpha1.Sub
 				continue
 			}
 
-			if err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, subjects); err != nil {
-				return reconcile.Result{}, err
+			bindingChanged, err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, subjects)
+			if err != nil {
+				return reconcile.Result{}, false, err
 			}
+			changed = changed || bindingChanged
 
 		case USER_ROLE_BINDING_IN_NAMESPACE:
-			if !strings.HasPrefix(roleBinding.Namespace, CUSTOM_NS_PREFIX) {
+			if !strings.HasPrefix(roleBinding.Namespace, lssv1alpha1.CustomNamespacePrefix) {
 				logger.Info("user role binding found outside of customer namespace. Reconcile skipped: " + roleBinding.Namespace)
 				continue
 			}
 
-			if err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, subjects); err != nil {
-				return reconcile.Result{}, err
+			bindingChanged, err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, subjects)
+			if err != nil {
+				return reconcile.Result{}, false, err
 			}
+			changed = changed || bindingChanged
 
 		case VIEWER_ROLE_BINDING_IN_NAMESPACE:
-			if !strings.HasPrefix(roleBinding.Namespace, CUSTOM_NS_PREFIX) {
+			if !strings.HasPrefix(roleBinding.Namespace, lssv1alpha1.CustomNamespacePrefix) {
 				logger.Info("viewer role binding found outside of customer namespace. Reconcile skipped: " + roleBinding.Namespace)
 				continue
 			}
 
-			if err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, viewerSubjects); err != nil {
-				return reconcile.Result{}, err
+			bindingChanged, err := UpdateRoleBindingSubjects(ctx, c.Client(), &roleBinding, viewerSubjects)
+			if err != nil {
+				return reconcile.Result{}, false, err
 			}
+			changed = changed || bindingChanged
 		}
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{}, changed, nil
 }
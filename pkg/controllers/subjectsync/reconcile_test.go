@@ -17,6 +17,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
@@ -30,7 +31,7 @@ var _ = Describe("Reconcile", func() {
 
 	const (
 		lsUserNamespace = subjectsync.LS_USER_NAMESPACE
-		userNamespace   = subjectsync.CUSTOM_NS_PREFIX + "user1"
+		userNamespace   = v1alpha1.CustomNamespacePrefix + "user1"
 	)
 	var (
 		op            *operation.TargetShootSidecarOperation
@@ -189,6 +190,26 @@ var _ = Describe("Reconcile", func() {
 		Expect(reflect.DeepEqual(viewerClusterRole.Rules, expectedViewerRules)).To(BeTrue())
 	})
 
+	It("should only record a synced event if the subjects have changed", func() {
+		var err error
+
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder := record.NewFakeRecorder(100)
+		ctrl.(*subjectsync.Controller).SetEventRecorder(recorder)
+
+		subjectlist := state.GetSubjectList(subjectsync.SUBJECT_LIST_NAME)
+		//reconcile for finalizer
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subjectlist))
+		//reconcile for actual run
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subjectlist))
+		Expect(recorder.Events).To(Receive(HavePrefix("Normal Synced")))
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(subjectlist))
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
 	It("should skip unknown/erroneous subjects", func() {
 		var err error
 
@@ -49,14 +49,14 @@ func CreateViewerSubjectsForSubjectList(ctx context.Context, subjectList *lssv1a
 // createSubjectForSubjectListEntry converts a single subject of the SubjectList custom resource into an rbac subject.
 func createSubjectForSubjectListEntry(subjectListEntry lssv1alpha1.Subject) (*rbacv1.Subject, error) {
 	switch subjectListEntry.Kind {
-	case SUBJECT_LIST_ENTRY_USER, SUBJECT_LIST_ENTRY_GROUP:
+	case lssv1alpha1.SubjectListEntryUser, lssv1alpha1.SubjectListEntryGroup:
 		// if the entry has a namespace, we ignore it
 		return &rbacv1.Subject{
 			APIGroup: "rbac.authorization.k8s.io",
 			Kind:     subjectListEntry.Kind,
 			Name:     subjectListEntry.Name,
 		}, nil
-	case SUBJECT_LIST_ENTRY_SERVICE_ACCOUNT:
+	case lssv1alpha1.SubjectListEntryServiceAccount:
 		// if the entry has no namespace, we use the LS_USER_NAMESPACE
 		namespace := subjectListEntry.Namespace
 		if namespace == "" {
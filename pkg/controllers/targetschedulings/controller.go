@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetschedulings
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// Controller is the targetscheduling controller
+type Controller struct {
+	operation.Operation
+	log logging.Logger
+}
+
+// NewTestActuator creates a new controller for testing purposes.
+func NewTestActuator(op operation.Operation, logger logging.Logger) *Controller {
+	return &Controller{
+		Operation: op,
+		log:       logger,
+	}
+}
+
+// NewController returns a new targetscheduling controller
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+	ctrl := &Controller{
+		log: logger,
+	}
+	op := operation.NewOperation(c, scheme, config)
+	op.SetEventRecorder(eventRecorder)
+	ctrl.Operation = *op
+	return ctrl, nil
+}
+
+// Reconcile reconciles requests for targetschedulings
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	scheduling := &lssv1alpha1.TargetScheduling{}
+	if err := c.Client().Get(ctx, req.NamespacedName, scheduling); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !scheduling.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	c.Operation.Scheme().Default(scheduling)
+	errHdl := c.handleErrorFunc(scheduling)
+
+	scheduling.Status.ObservedGeneration = scheduling.GetGeneration()
+
+	return reconcile.Result{}, errHdl(ctx, c.reconcile(ctx, scheduling))
+}
+
+// MapLandscaperDeploymentToTargetSchedulings maps a landscaper deployment to the target schedulings whose rules placed it,
+// so that the number of placed deployments is updated when a landscaper deployment is scheduled or deleted.
+func (c *Controller) MapLandscaperDeploymentToTargetSchedulings(_ context.Context, obj client.Object) []reconcile.Request {
+	deployment, ok := obj.(*lssv1alpha1.LandscaperDeployment)
+	if !ok {
+		return nil
+	}
+
+	keys := placingSchedulings(deployment, c.Config().Scheduling)
+	requests := make([]reconcile.Request, 0, len(keys))
+	for _, key := range keys {
+		requests = append(requests, reconcile.Request{NamespacedName: key})
+	}
+	return requests
+}
+
+// MapTargetSchedulingToTargetSchedulings maps a target scheduling to all other target schedulings,
+// so that the conflicts between the rules of the target schedulings are updated when one of them is changed.
+func (c *Controller) MapTargetSchedulingToTargetSchedulings(ctx context.Context, obj client.Object) []reconcile.Request {
+	schedulingList := &lssv1alpha1.TargetSchedulingList{}
+	if err := c.Client().List(ctx, schedulingList); err != nil {
+		c.log.Error(err, "unable to map target scheduling to target schedulings", lc.KeyResource, client.ObjectKeyFromObject(obj).String())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(schedulingList.Items))
+	for i := range schedulingList.Items {
+		key := client.ObjectKeyFromObject(&schedulingList.Items[i])
+		if key != client.ObjectKeyFromObject(obj) {
+			requests = append(requests, reconcile.Request{NamespacedName: key})
+		}
+	}
+	return requests
+}
+
+// handleErrorFunc updates the error status of a target scheduling
+func (c *Controller) handleErrorFunc(scheduling *lssv1alpha1.TargetScheduling) func(ctx context.Context, err error) error {
+	old := scheduling.DeepCopy()
+	return func(ctx context.Context, err error) error {
+		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(scheduling).String()})
+		scheduling.Status.LastError = lsserrors.TryUpdateError(scheduling.Status.LastError, err)
+		utils.RecordErrorEvent(c.EventRecorder(), scheduling, "ReconcileFailed", err)
+
+		if !reflect.DeepEqual(old.Status, scheduling.Status) {
+			if err2 := c.Client().Status().Update(ctx, scheduling); err2 != nil {
+				if apierrors.IsConflict(err2) {
+					// reduce logging
+					logger.Info(fmt.Sprintf("unable to update status: %s", err2.Error()))
+				} else {
+					logger.Error(err2, "unable to update status")
+				}
+
+				// retry on conflict
+				if err != nil {
+					return err2
+				}
+			}
+		}
+		return err
+	}
+}
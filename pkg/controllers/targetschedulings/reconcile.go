@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetschedulings
+
+import (
+	"context"
+	"slices"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	lssscheduling "github.com/gardener/landscaper-service/pkg/controllers/landscaperdeployments/scheduling"
+)
+
+// reconcile determines whether the rules of the target scheduling are used for the scheduling, how many landscaper deployments
+// have been placed by its rules and which of its rules conflict with rules of other active target schedulings.
+// The status is persisted by the error handler.
+func (c *Controller) reconcile(ctx context.Context, scheduling *lssv1alpha1.TargetScheduling) error {
+	_, ctx = logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(scheduling).String()},
+		lc.KeyMethod, "reconcile")
+	currOp := "Reconcile"
+
+	active, err := lssscheduling.IsActiveScheduling(scheduling, c.Config().Scheduling, c.Config().SchedulingSelector)
+	if err != nil {
+		return lsserrors.NewWrappedError(err, currOp, "InvalidSchedulingSelector", err.Error())
+	}
+
+	var conflicts []string
+	if active {
+		merged, err := lssscheduling.GetMergedScheduling(ctx, c.Client(), c.Config().Scheduling, c.Config().SchedulingSelector)
+		if err != nil {
+			return lsserrors.NewWrappedError(err, currOp, "GetSchedulingResources", err.Error())
+		}
+
+		conflicts = merged.ConflictsOf(lssv1alpha1.ObjectReference{Name: scheduling.Name, Namespace: scheduling.Namespace})
+		if len(conflicts) == 0 {
+			// an empty list is omitted in the stored status
+			conflicts = nil
+		}
+	}
+
+	deploymentList := &lssv1alpha1.LandscaperDeploymentList{}
+	if err := c.Client().List(ctx, deploymentList); err != nil {
+		return lsserrors.NewWrappedError(err, currOp, "ListLandscaperDeployments", err.Error())
+	}
+
+	key := client.ObjectKeyFromObject(scheduling)
+	placed := 0
+	for i := range deploymentList.Items {
+		if slices.Contains(placingSchedulings(&deploymentList.Items[i], c.Config().Scheduling), key) {
+			placed++
+		}
+	}
+
+	scheduling.Status.Active = active
+	scheduling.Status.PlacedDeployments = placed
+	scheduling.Status.Conflicts = conflicts
+	return nil
+}
+
+// placingSchedulings returns the keys of the target schedulings, whose rules have been applied to place the given landscaper deployment.
+// Scheduling decisions which have been recorded before the rules of several target schedulings were merged do not reference
+// the target scheduling of a matched rule. These rules are attributed to the target scheduling referenced by the configuration.
+func placingSchedulings(deployment *lssv1alpha1.LandscaperDeployment, schedulingRef *lsv1alpha1.ObjectReference) []types.NamespacedName {
+	decision := deployment.Status.SchedulingDecision
+	if decision == nil || decision.Selected == nil {
+		return nil
+	}
+
+	keys := make([]types.NamespacedName, 0)
+	for _, rule := range decision.MatchedRules {
+		if !rule.Applied {
+			continue
+		}
+
+		var key types.NamespacedName
+		if rule.Scheduling != nil {
+			key = rule.Scheduling.NamespacedName()
+		} else if schedulingRef != nil {
+			key = schedulingRef.NamespacedName()
+		} else {
+			continue
+		}
+
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
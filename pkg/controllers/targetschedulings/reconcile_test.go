@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetschedulings_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	targetschedulingscontroller "github.com/gardener/landscaper-service/pkg/controllers/targetschedulings"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	testutils "github.com/gardener/landscaper-service/test/utils"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+var _ = Describe("Reconcile", func() {
+	var (
+		op    *operation.Operation
+		ctrl  *targetschedulingscontroller.Controller
+		ctx   context.Context
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		config := testutils.DefaultControllerConfiguration()
+		config.Scheduling = &lsv1alpha1.ObjectReference{
+			Name:      "scheduling",
+			Namespace: state.Namespace,
+		}
+		config.SchedulingSelector = &metav1.LabelSelector{
+			MatchLabels: map[string]string{"test.landscaper-service.gardener.cloud/scheduling": state.Namespace},
+		}
+
+		op = operation.NewOperation(testenv.Client, envtest.LandscaperServiceScheme, config)
+		ctrl = targetschedulingscontroller.NewTestActuator(*op, logging.Discard())
+	})
+
+	AfterEach(func() {
+		defer ctx.Done()
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should report the status of the referenced target scheduling", func() {
+		scheduling := state.GetTargetScheduling("scheduling")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(scheduling))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(scheduling), scheduling)).To(Succeed())
+		Expect(scheduling.Status.ObservedGeneration).To(Equal(scheduling.Generation))
+		Expect(scheduling.Status.Active).To(BeTrue())
+		// the legacy decision without a reference to a target scheduling is attributed to the referenced target scheduling
+		Expect(scheduling.Status.PlacedDeployments).To(Equal(1))
+		Expect(scheduling.Status.Conflicts).To(HaveLen(1))
+		Expect(scheduling.Status.Conflicts[0]).To(ContainSubstring("different priorities 10 and 20"))
+	})
+
+	It("should report the status of a selected target scheduling", func() {
+		scheduling := state.GetTargetScheduling("team")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(scheduling))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(scheduling), scheduling)).To(Succeed())
+		Expect(scheduling.Status.Active).To(BeTrue())
+		// the failed scheduling is not counted
+		Expect(scheduling.Status.PlacedDeployments).To(Equal(1))
+		Expect(scheduling.Status.Conflicts).To(HaveLen(1))
+
+		Expect(testenv.Client.Delete(ctx, state.GetDeployment("team"))).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(scheduling))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(scheduling), scheduling)).To(Succeed())
+		Expect(scheduling.Status.PlacedDeployments).To(Equal(0))
+	})
+
+	It("should report a target scheduling which is neither referenced nor selected as inactive", func() {
+		scheduling := state.GetTargetScheduling("inactive")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(scheduling))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(scheduling), scheduling)).To(Succeed())
+		Expect(scheduling.Status.Active).To(BeFalse())
+		Expect(scheduling.Status.PlacedDeployments).To(Equal(0))
+		Expect(scheduling.Status.Conflicts).To(BeEmpty())
+	})
+
+	It("should map landscaper deployments to the target schedulings whose rules placed them", func() {
+		requests := ctrl.MapLandscaperDeploymentToTargetSchedulings(ctx, state.GetDeployment("team"))
+		Expect(requests).To(ConsistOf(testutils.RequestFromObject(state.GetTargetScheduling("team"))))
+
+		requests = ctrl.MapLandscaperDeploymentToTargetSchedulings(ctx, state.GetDeployment("legacy"))
+		Expect(requests).To(ConsistOf(testutils.RequestFromObject(state.GetTargetScheduling("scheduling"))))
+
+		requests = ctrl.MapLandscaperDeploymentToTargetSchedulings(ctx, state.GetDeployment("failed"))
+		Expect(requests).To(BeEmpty())
+	})
+})
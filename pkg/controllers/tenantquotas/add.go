@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tenantquotas
+
+import (
+	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// AddControllerToManager adds the controller to the manager
+func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.LandscaperServiceConfiguration) error {
+	log := logger.Reconciles("tenantQuota", "TenantQuota")
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("landscaper-service-tenantquotas"), config)
+	if err != nil {
+		return err
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		For(&v1alpha1.TenantQuota{}).
+		Watches(&v1alpha1.LandscaperDeployment{}, handler.EnqueueRequestsFromMapFunc(ctrl.(*Controller).MapLandscaperDeploymentToTenantQuotas)).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
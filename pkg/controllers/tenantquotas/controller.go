@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tenantquotas
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// Controller is the tenantquota controller
+type Controller struct {
+	operation.Operation
+	log logging.Logger
+}
+
+// NewTestActuator creates a new controller for testing purposes.
+func NewTestActuator(op operation.Operation, logger logging.Logger) *Controller {
+	return &Controller{
+		Operation: op,
+		log:       logger,
+	}
+}
+
+// NewController returns a new tenantquota controller
+func NewController(logger logging.Logger, c client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+	ctrl := &Controller{
+		log: logger,
+	}
+	op := operation.NewOperation(c, scheme, config)
+	op.SetEventRecorder(eventRecorder)
+	ctrl.Operation = *op
+	return ctrl, nil
+}
+
+// Reconcile reconciles requests for tenantquotas
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	quota := &lssv1alpha1.TenantQuota{}
+	if err := c.Client().Get(ctx, req.NamespacedName, quota); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !quota.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	c.Operation.Scheme().Default(quota)
+	errHdl := c.handleErrorFunc(quota)
+
+	quota.Status.ObservedGeneration = quota.GetGeneration()
+
+	return reconcile.Result{}, errHdl(ctx, c.reconcile(ctx, quota))
+}
+
+// MapLandscaperDeploymentToTenantQuotas maps a landscaper deployment to the tenant quotas of its tenant,
+// so that the usage of the tenant quotas is updated when a landscaper deployment is created, changed or deleted.
+func (c *Controller) MapLandscaperDeploymentToTenantQuotas(ctx context.Context, obj client.Object) []reconcile.Request {
+	deployment, ok := obj.(*lssv1alpha1.LandscaperDeployment)
+	if !ok {
+		return nil
+	}
+
+	quotas, err := utils.ListTenantQuotas(ctx, c.Client(), deployment.Spec.TenantId)
+	if err != nil {
+		c.log.Error(err, "unable to map landscaper deployment to tenant quotas", lc.KeyResource, client.ObjectKeyFromObject(deployment).String())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(quotas))
+	for i := range quotas {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&quotas[i])})
+	}
+	return requests
+}
+
+// handleErrorFunc updates the error status of a tenant quota
+func (c *Controller) handleErrorFunc(quota *lssv1alpha1.TenantQuota) func(ctx context.Context, err error) error {
+	old := quota.DeepCopy()
+	return func(ctx context.Context, err error) error {
+		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(quota).String()})
+		quota.Status.LastError = lsserrors.TryUpdateError(quota.Status.LastError, err)
+		utils.RecordErrorEvent(c.EventRecorder(), quota, "ReconcileFailed", err)
+
+		if !reflect.DeepEqual(old.Status, quota.Status) {
+			if err2 := c.Client().Status().Update(ctx, quota); err2 != nil {
+				if apierrors.IsConflict(err2) {
+					// reduce logging
+					logger.Info(fmt.Sprintf("unable to update status: %s", err2.Error()))
+				} else {
+					logger.Error(err2, "unable to update status")
+				}
+
+				// retry on conflict
+				if err != nil {
+					return err2
+				}
+			}
+		}
+		return err
+	}
+}
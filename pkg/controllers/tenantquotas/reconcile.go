@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tenantquotas
+
+import (
+	"context"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// reconcile aggregates the usage of the landscaper deployments of the tenant and records it in the status of the tenant quota.
+// The status is persisted by the error handler.
+func (c *Controller) reconcile(ctx context.Context, quota *lssv1alpha1.TenantQuota) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(quota).String()},
+		lc.KeyMethod, "reconcile")
+	currOp := "Reconcile"
+
+	deployments, err := utils.ListTenantLandscaperDeployments(ctx, c.Client(), quota.Spec.TenantId)
+	if err != nil {
+		return lsserrors.NewWrappedError(err, currOp, "ListLandscaperDeployments", err.Error())
+	}
+
+	usage := utils.NewTenantQuotaUsage()
+	for i := range deployments {
+		if err := utils.AddTenantQuotaUsage(&usage, &deployments[i]); err != nil {
+			logger.Info("Ignoring invalid resource requests", lc.KeyError, err.Error())
+		}
+	}
+
+	old := quota.Status.Used
+	if old.RequestedCPU != nil && usage.RequestedCPU.Cmp(*old.RequestedCPU) == 0 {
+		// keep the previous representation, so that the status is not updated needlessly
+		usage.RequestedCPU = old.RequestedCPU
+	}
+	if old.RequestedMemory != nil && usage.RequestedMemory.Cmp(*old.RequestedMemory) == 0 {
+		usage.RequestedMemory = old.RequestedMemory
+	}
+
+	quota.Status.Used = usage
+	return nil
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tenantquotas_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	tenantquotascontroller "github.com/gardener/landscaper-service/pkg/controllers/tenantquotas"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	testutils "github.com/gardener/landscaper-service/test/utils"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+var _ = Describe("Reconcile", func() {
+	var (
+		op    *operation.Operation
+		ctrl  *tenantquotascontroller.Controller
+		ctx   context.Context
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		op = operation.NewOperation(testenv.Client, envtest.LandscaperServiceScheme, testutils.DefaultControllerConfiguration())
+		ctrl = tenantquotascontroller.NewTestActuator(*op, logging.Discard())
+	})
+
+	AfterEach(func() {
+		defer ctx.Done()
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should report the usage of the tenant", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		quota := state.GetTenantQuota("test")
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(quota))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(quota), quota)).To(Succeed())
+		Expect(quota.Status.ObservedGeneration).To(Equal(quota.Generation))
+
+		used := quota.Status.Used
+		Expect(used.Instances).ToNot(BeNil())
+		Expect(*used.Instances).To(Equal(2))
+		Expect(used.HighAvailabilityInstances).ToNot(BeNil())
+		Expect(*used.HighAvailabilityInstances).To(Equal(1))
+		Expect(used.RequestedCPU.Cmp(resource.MustParse("750m"))).To(Equal(0))
+		Expect(used.RequestedMemory.Cmp(resource.MustParse("1536Mi"))).To(Equal(0))
+
+		Expect(testenv.Client.Delete(ctx, state.GetDeployment("test-ha"))).To(Succeed())
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(quota))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(quota), quota)).To(Succeed())
+
+		used = quota.Status.Used
+		Expect(*used.Instances).To(Equal(1))
+		Expect(*used.HighAvailabilityInstances).To(Equal(0))
+		Expect(used.RequestedCPU.Cmp(resource.MustParse("500m"))).To(Equal(0))
+		Expect(used.RequestedMemory.Cmp(resource.MustParse("1Gi"))).To(Equal(0))
+	})
+
+	It("should map landscaper deployments to the tenant quotas of their tenant", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		quota := state.GetTenantQuota("test")
+
+		requests := ctrl.MapLandscaperDeploymentToTenantQuotas(ctx, state.GetDeployment("test"))
+		Expect(requests).To(ConsistOf(testutils.RequestFromObject(quota)))
+
+		requests = ctrl.MapLandscaperDeploymentToTenantQuotas(ctx, state.GetDeployment("other"))
+		Expect(requests).To(BeEmpty())
+	})
+})
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// AddControllerToManager adds the upgrade controller to the manager
+func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config *config.LandscaperServiceConfiguration) error {
+	log := logger.Reconciles("upgrade", "Instance")
+	ctrl, err := NewController(log, mgr.GetClient(), mgr.GetAPIReader(), mgr.GetScheme(), mgr.GetEventRecorderFor("landscaper-service-upgrade"), config)
+	if err != nil {
+		return err
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named("upgrade").
+		For(&lssv1alpha1.Instance{}).
+		// the concurrency limit of the upgrades relies on the instances being processed one after the other
+		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+
+	config "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/metrics"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+// Controller rolls out a new default version of the landscaper service component to the instances.
+// The upgrade of an instance is approved inside its maintenance window, as long as the number of upgrades in progress
+// is below the configured limit and none of the already upgraded instances is unhealthy.
+type Controller struct {
+	operation.Operation
+	log logging.Logger
+	// apiReader reads the instances directly from the api server when counting the upgrades in progress,
+	// so that the approvals of the previous reconciles are always taken into account.
+	apiReader client.Reader
+}
+
+// NewController returns a new upgrade controller
+func NewController(logger logging.Logger, c client.Client, apiReader client.Reader, scheme *runtime.Scheme, eventRecorder record.EventRecorder, config *config.LandscaperServiceConfiguration) (reconcile.Reconciler, error) {
+	ctrl := &Controller{
+		log:       logger,
+		apiReader: apiReader,
+	}
+	op := operation.NewOperation(c, scheme, config)
+	op.SetEventRecorder(eventRecorder)
+	ctrl.Operation = *op
+	return ctrl, nil
+}
+
+// NewTestActuator creates a new controller for testing purposes.
+func NewTestActuator(op operation.Operation, logger logging.Logger) *Controller {
+	ctrl := &Controller{
+		Operation: op,
+		log:       logger,
+		apiReader: op.Client(),
+	}
+	return ctrl
+}
+
+// Reconcile approves the upgrade of an instance to the default version of the landscaper service component.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	instance := &lssv1alpha1.Instance{}
+	if err := c.Client().Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !instance.DeletionTimestamp.IsZero() ||
+		utils.IsOrphaned(instance) ||
+		utils.HasOperationAnnotation(instance, lssv1alpha1.LandscaperServiceOperationIgnore) {
+		return reconcile.Result{}, nil
+	}
+
+	version := c.Config().LandscaperServiceComponent.Version
+	if !utils.UpgradeRequiresApproval(instance, version) || utils.IsUpgradeApproved(instance, version) {
+		return reconcile.Result{}, nil
+	}
+
+	if window := c.getMaintenanceWindow(instance); window != nil {
+		untilWindow, err := utils.DurationUntilMaintenanceWindow(window, time.Now())
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("invalid maintenance window: %w", err)
+		}
+		if untilWindow > 0 {
+			logger.Debug("waiting for the maintenance window", "duration", untilWindow.String())
+			return reconcile.Result{RequeueAfter: untilWindow}, nil
+		}
+	}
+
+	inProgress, unhealthy, err := c.getUpgradeState(ctx, version)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	checkInterval := c.Config().Upgrade.CheckInterval.Duration
+
+	if len(unhealthy) > 0 {
+		logger.Info("upgrade is halted, since upgraded instances are unhealthy", "version", version, "unhealthyInstances", strings.Join(unhealthy, ","))
+		c.EventRecorder().Eventf(instance, corev1.EventTypeWarning, "UpgradeHalted",
+			"upgrade to version %s is halted, since the upgraded instances %s are unhealthy", version, strings.Join(unhealthy, ", "))
+		return reconcile.Result{RequeueAfter: checkInterval}, nil
+	}
+
+	if len(inProgress) >= c.Config().Upgrade.MaxConcurrentUpgrades {
+		logger.Debug("waiting for upgrades in progress", "version", version, "inProgress", strings.Join(inProgress, ","))
+		return reconcile.Result{RequeueAfter: checkInterval}, nil
+	}
+
+	installedVersion := utils.InstalledVersion(instance)
+	metav1.SetMetaDataAnnotation(&instance.ObjectMeta, lssv1alpha1.LandscaperServiceUpgradeApprovedAnnotation, version)
+	if err := c.Client().Update(ctx, instance); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to approve upgrade: %w", err)
+	}
+
+	logger.Info("upgrade approved", "from", installedVersion, "to", version)
+	metrics.UpgradesApproved.Inc()
+	c.EventRecorder().Eventf(instance, corev1.EventTypeNormal, "UpgradeApproved",
+		"upgrade from version %s to %s has been approved", installedVersion, version)
+
+	return reconcile.Result{}, nil
+}
+
+// getMaintenanceWindow returns the maintenance window of the instance, or the default maintenance window.
+func (c *Controller) getMaintenanceWindow(instance *lssv1alpha1.Instance) *lssv1alpha1.MaintenanceWindow {
+	if instance.Spec.MaintenanceWindow != nil {
+		return instance.Spec.MaintenanceWindow
+	}
+	return c.Config().Upgrade.DefaultMaintenanceWindow
+}
+
+// getUpgradeState returns the instances whose upgrade to the given version is in progress,
+// and the upgraded instances which are unhealthy.
+// An upgrade is in progress until the installation of the version has succeeded and the instance is ready.
+// An upgraded instance is unhealthy, if its installation has failed or the health watcher reports it as unhealthy.
+func (c *Controller) getUpgradeState(ctx context.Context, version string) ([]string, []string, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "getUpgradeState")
+
+	instanceList := &lssv1alpha1.InstanceList{}
+	if err := c.apiReader.List(ctx, instanceList); err != nil {
+		logger.Error(err, "unable to list instances")
+		return nil, nil, fmt.Errorf("unable to list instances: %w", err)
+	}
+
+	inProgress := make([]string, 0)
+	unhealthy := make([]string, 0)
+
+	for i := range instanceList.Items {
+		instance := &instanceList.Items[i]
+		if len(instance.Spec.Version) > 0 || !utils.IsUpgradeApproved(instance, version) {
+			continue
+		}
+
+		key := client.ObjectKeyFromObject(instance).String()
+
+		if meta.IsStatusConditionFalse(instance.Status.Conditions, lssv1alpha1.InstanceConditionInstallationSucceeded) ||
+			meta.IsStatusConditionFalse(instance.Status.Conditions, lssv1alpha1.InstanceConditionHealthy) {
+			unhealthy = append(unhealthy, key)
+			continue
+		}
+
+		if utils.InstalledVersion(instance) != version ||
+			!meta.IsStatusConditionTrue(instance.Status.Conditions, lssv1alpha1.InstanceConditionReady) {
+			inProgress = append(inProgress, key)
+		}
+	}
+
+	metrics.UpgradesInProgress.Set(float64(len(inProgress)))
+	metrics.UpgradesHalted.Set(0)
+	if len(unhealthy) > 0 {
+		metrics.UpgradesHalted.Set(1)
+	}
+
+	return inProgress, unhealthy, nil
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/operation"
+	"github.com/gardener/landscaper-service/pkg/utils"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+
+	upgrade "github.com/gardener/landscaper-service/pkg/controllers/upgrade"
+	testutils "github.com/gardener/landscaper-service/test/utils"
+)
+
+var _ = Describe("Reconcile", func() {
+	var (
+		op    *operation.Operation
+		ctrl  reconcile.Reconciler
+		ctx   context.Context
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		op = operation.NewOperation(testenv.Client, envtest.LandscaperServiceScheme, testutils.DefaultControllerConfiguration())
+		ctrl = upgrade.NewTestActuator(*op, logging.Discard())
+	})
+
+	AfterEach(func() {
+		defer ctx.Done()
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should approve the upgrade of an instance", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("pending")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Annotations).To(HaveKeyWithValue(lssv1alpha1.LandscaperServiceUpgradeApprovedAnnotation, "v1.1.1"))
+	})
+
+	It("should not approve the upgrade outside of the maintenance window", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		now := time.Now().UTC()
+		instance := state.GetInstance("pending")
+		instance.Spec.MaintenanceWindow = &lssv1alpha1.MaintenanceWindow{
+			Begin: now.Add(2 * time.Hour).Format(utils.MaintenanceWindowTimeFormat),
+			End:   now.Add(3 * time.Hour).Format(utils.MaintenanceWindowTimeFormat),
+		}
+		Expect(testenv.Client.Update(ctx, instance)).To(Succeed())
+
+		result := testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(metav1.HasAnnotation(instance.ObjectMeta, lssv1alpha1.LandscaperServiceUpgradeApprovedAnnotation)).To(BeFalse())
+	})
+
+	It("should not approve the upgrade when the maximum number of concurrent upgrades is reached", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test2")
+		Expect(err).ToNot(HaveOccurred())
+
+		instance := state.GetInstance("pending")
+		result := testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(result.RequeueAfter).To(Equal(op.Config().Upgrade.CheckInterval.Duration))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(metav1.HasAnnotation(instance.ObjectMeta, lssv1alpha1.LandscaperServiceUpgradeApprovedAnnotation)).To(BeFalse())
+
+		op.Config().Upgrade.MaxConcurrentUpgrades = 2
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(instance.Annotations).To(HaveKeyWithValue(lssv1alpha1.LandscaperServiceUpgradeApprovedAnnotation, "v1.1.1"))
+	})
+
+	It("should not approve more upgrades than the maximum number of concurrent upgrades in back-to-back reconciles", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test4")
+		Expect(err).ToNot(HaveOccurred())
+
+		first := state.GetInstance("first")
+		second := state.GetInstance("second")
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(first))
+		result := testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(second))
+		Expect(result.RequeueAfter).To(Equal(op.Config().Upgrade.CheckInterval.Duration))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(first), first)).To(Succeed())
+		Expect(first.Annotations).To(HaveKeyWithValue(lssv1alpha1.LandscaperServiceUpgradeApprovedAnnotation, "v1.1.1"))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(second), second)).To(Succeed())
+		Expect(metav1.HasAnnotation(second.ObjectMeta, lssv1alpha1.LandscaperServiceUpgradeApprovedAnnotation)).To(BeFalse())
+	})
+
+	It("should halt the upgrade when an upgraded instance is unhealthy", func() {
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/reconcile/test3")
+		Expect(err).ToNot(HaveOccurred())
+
+		op.Config().Upgrade.MaxConcurrentUpgrades = 2
+
+		instance := state.GetInstance("pending")
+		result := testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(instance))
+		Expect(result.RequeueAfter).To(Equal(op.Config().Upgrade.CheckInterval.Duration))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		Expect(metav1.HasAnnotation(instance.ObjectMeta, lssv1alpha1.LandscaperServiceUpgradeApprovedAnnotation)).To(BeFalse())
+	})
+})
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// inventoryTimeout is the maximum time a scrape of the inventory metrics may take.
+const inventoryTimeout = 10 * time.Second
+
+var (
+	instancesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "", "instances"),
+		"Number of instances per phase and service target config.",
+		[]string{LabelPhase, LabelServiceTargetConfigNamespace, LabelServiceTargetConfigName}, nil)
+
+	landscaperDeploymentsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "", "landscaper_deployments"),
+		"Number of landscaper deployments per data plane type.",
+		[]string{LabelDataPlaneType}, nil)
+)
+
+// inventoryCollector collects the number of instances and landscaper deployments at scrape time.
+// The resources are listed from the given reader on every scrape, so that the metrics never diverge from the cluster state.
+type inventoryCollector struct {
+	reader client.Reader
+	log    logging.Logger
+}
+
+// NewInventoryCollector creates a collector for the instances and landscaper deployments in the cluster.
+func NewInventoryCollector(reader client.Reader, logger logging.Logger) prometheus.Collector {
+	return &inventoryCollector{
+		reader: reader,
+		log:    logger,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *inventoryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- instancesDesc
+	ch <- landscaperDeploymentsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *inventoryCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), inventoryTimeout)
+	defer cancel()
+
+	c.collectInstances(ctx, ch)
+	c.collectLandscaperDeployments(ctx, ch)
+}
+
+type instancesKey struct {
+	phase                        string
+	serviceTargetConfigNamespace string
+	serviceTargetConfigName      string
+}
+
+func (c *inventoryCollector) collectInstances(ctx context.Context, ch chan<- prometheus.Metric) {
+	instanceList := &lssv1alpha1.InstanceList{}
+	if err := c.reader.List(ctx, instanceList); err != nil {
+		c.log.Error(err, "unable to list instances for metrics")
+		return
+	}
+
+	counts := map[instancesKey]int{}
+	for _, instance := range instanceList.Items {
+		counts[instancesKey{
+			phase:                        instance.Status.Phase,
+			serviceTargetConfigNamespace: instance.Spec.ServiceTargetConfigRef.Namespace,
+			serviceTargetConfigName:      instance.Spec.ServiceTargetConfigRef.Name,
+		}]++
+	}
+
+	for key, count := range counts {
+		ch <- prometheus.MustNewConstMetric(instancesDesc, prometheus.GaugeValue, float64(count),
+			key.phase, key.serviceTargetConfigNamespace, key.serviceTargetConfigName)
+	}
+}
+
+func (c *inventoryCollector) collectLandscaperDeployments(ctx context.Context, ch chan<- prometheus.Metric) {
+	deploymentList := &lssv1alpha1.LandscaperDeploymentList{}
+	if err := c.reader.List(ctx, deploymentList); err != nil {
+		c.log.Error(err, "unable to list landscaper deployments for metrics")
+		return
+	}
+
+	counts := map[string]int{
+		DataPlaneTypeInternal: 0,
+		DataPlaneTypeExternal: 0,
+	}
+	for _, deployment := range deploymentList.Items {
+		if deployment.IsExternalDataPlane() {
+			counts[DataPlaneTypeExternal]++
+		} else {
+			counts[DataPlaneTypeInternal]++
+		}
+	}
+
+	for dataPlaneType, count := range counts {
+		ch <- prometheus.MustNewConstMetric(landscaperDeploymentsDesc, prometheus.GaugeValue, float64(count), dataPlaneType)
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lssinstall "github.com/gardener/landscaper-service/pkg/apis/core/install"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/metrics"
+)
+
+// collect returns the gauge values of the collector by the joined values of their labels.
+func collect(collector prometheus.Collector) map[string]float64 {
+	ch := make(chan prometheus.Metric, 100)
+	collector.Collect(ch)
+	close(ch)
+
+	values := map[string]float64{}
+	for metric := range ch {
+		m := &dto.Metric{}
+		Expect(metric.Write(m)).To(Succeed())
+
+		key := ""
+		for _, label := range m.GetLabel() {
+			key += label.GetName() + "=" + label.GetValue() + ";"
+		}
+		values[key] = m.GetGauge().GetValue()
+	}
+	return values
+}
+
+var _ = Describe("Inventory", func() {
+	It("should count the instances by phase and service target config and the deployments by data plane type", func() {
+		scheme := runtime.NewScheme()
+		lssinstall.Install(scheme)
+
+		newInstance := func(name, phase, serviceTargetConfig string) *lssv1alpha1.Instance {
+			return &lssv1alpha1.Instance{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+				Spec: lssv1alpha1.InstanceSpec{
+					ServiceTargetConfigRef: lssv1alpha1.ObjectReference{Name: serviceTargetConfig, Namespace: "laas-system"},
+				},
+				Status: lssv1alpha1.InstanceStatus{Phase: phase},
+			}
+		}
+
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			newInstance("instance-1", "Succeeded", "stc-1"),
+			newInstance("instance-2", "Succeeded", "stc-1"),
+			newInstance("instance-3", "Failed", "stc-1"),
+			newInstance("instance-4", "Succeeded", "stc-2"),
+			&lssv1alpha1.LandscaperDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment-1", Namespace: "test"},
+			},
+			&lssv1alpha1.LandscaperDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment-2", Namespace: "test"},
+				Spec: lssv1alpha1.LandscaperDeploymentSpec{
+					DataPlane: &lssv1alpha1.DataPlane{Kubeconfig: "kubeconfig"},
+				},
+			},
+		).Build()
+
+		values := collect(metrics.NewInventoryCollector(c, logging.Discard()))
+		Expect(values).To(Equal(map[string]float64{
+			"phase=Succeeded;service_target_config_name=stc-1;service_target_config_namespace=laas-system;": 2,
+			"phase=Failed;service_target_config_name=stc-1;service_target_config_namespace=laas-system;":    1,
+			"phase=Succeeded;service_target_config_name=stc-2;service_target_config_namespace=laas-system;": 1,
+			"data_plane_type=internal;": 1,
+			"data_plane_type=external;": 1,
+		}))
+	})
+})
@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// Namespace is the namespace of all landscaper service metrics.
+	Namespace = "landscaper_service"
+
+	// LabelNamespace is the label for the namespace of a resource.
+	LabelNamespace = "namespace"
+	// LabelName is the label for the name of a resource.
+	LabelName = "name"
+	// LabelPhase is the label for the phase of an instance.
+	LabelPhase = "phase"
+	// LabelServiceTargetConfigNamespace is the label for the namespace of the service target config of a resource.
+	LabelServiceTargetConfigNamespace = "service_target_config_namespace"
+	// LabelServiceTargetConfigName is the label for the name of the service target config of a resource.
+	LabelServiceTargetConfigName = "service_target_config_name"
+	// LabelDataPlaneType is the label for the data plane type of a landscaper deployment.
+	LabelDataPlaneType = "data_plane_type"
+	// LabelReason is the label for the reason of a failure.
+	LabelReason = "reason"
+	// LabelResult is the label for the result of an operation.
+	LabelResult = "result"
+	// LabelKind is the label for the kind of a resource.
+	LabelKind = "kind"
+)
+
+const (
+	// ResultSuccess is the result label value of a successful operation.
+	ResultSuccess = "success"
+	// ResultFailure is the result label value of a failed operation.
+	ResultFailure = "failure"
+
+	// DataPlaneTypeInternal is the data plane type label value of landscaper deployments with an internal data plane.
+	DataPlaneTypeInternal = "internal"
+	// DataPlaneTypeExternal is the data plane type label value of landscaper deployments with an external data plane.
+	DataPlaneTypeExternal = "external"
+)
+
+const (
+	serviceTargetConfigSubsystem = "service_target_config"
+	schedulingSubsystem          = "scheduling"
+	availabilitySubsystem        = "availability"
+	avsUploadSubsystem           = "avs_upload"
+	upgradeSubsystem             = "upgrade"
+)
+
+var (
+	// ServiceTargetConfigInstances is the number of instances deployed on the target cluster of a service target config.
+	ServiceTargetConfigInstances = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: serviceTargetConfigSubsystem,
+		Name:      "instances",
+		Help:      "Number of instances deployed on the target cluster of a service target config.",
+	}, []string{LabelNamespace, LabelName})
+
+	// ServiceTargetConfigInstancesByPhase is the number of instances per phase deployed on the target cluster of a service target config.
+	ServiceTargetConfigInstancesByPhase = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: serviceTargetConfigSubsystem,
+		Name:      "instances_by_phase",
+		Help:      "Number of instances per phase deployed on the target cluster of a service target config.",
+	}, []string{LabelNamespace, LabelName, LabelPhase})
+
+	// ServiceTargetConfigRequestedCPU is the sum of the cpu requests of the instances of a service target config.
+	ServiceTargetConfigRequestedCPU = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: serviceTargetConfigSubsystem,
+		Name:      "requested_cpu_cores",
+		Help:      "Sum of the cpu requests of the landscaper resources of the instances of a service target config.",
+	}, []string{LabelNamespace, LabelName})
+
+	// ServiceTargetConfigRequestedMemory is the sum of the memory requests of the instances of a service target config.
+	ServiceTargetConfigRequestedMemory = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: serviceTargetConfigSubsystem,
+		Name:      "requested_memory_bytes",
+		Help:      "Sum of the memory requests of the landscaper resources of the instances of a service target config.",
+	}, []string{LabelNamespace, LabelName})
+
+	// ServiceTargetConfigAllocatableCPU is the allocatable cpu of the nodes of the target cluster of a service target config.
+	ServiceTargetConfigAllocatableCPU = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: serviceTargetConfigSubsystem,
+		Name:      "allocatable_cpu_cores",
+		Help:      "Sum of the allocatable cpu of the nodes of the target cluster of a service target config.",
+	}, []string{LabelNamespace, LabelName})
+
+	// ServiceTargetConfigAllocatableMemory is the allocatable memory of the nodes of the target cluster of a service target config.
+	ServiceTargetConfigAllocatableMemory = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: serviceTargetConfigSubsystem,
+		Name:      "allocatable_memory_bytes",
+		Help:      "Sum of the allocatable memory of the nodes of the target cluster of a service target config.",
+	}, []string{LabelNamespace, LabelName})
+
+	// SchedulingDecisions is the number of landscaper deployments which have been scheduled onto a service target config.
+	SchedulingDecisions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: schedulingSubsystem,
+		Name:      "decisions_total",
+		Help:      "Number of landscaper deployments which have been scheduled onto a service target config.",
+	}, []string{LabelServiceTargetConfigNamespace, LabelServiceTargetConfigName})
+
+	// SchedulingFailures is the number of failed scheduling attempts of landscaper deployments.
+	SchedulingFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: schedulingSubsystem,
+		Name:      "failures_total",
+		Help:      "Number of failed scheduling attempts of landscaper deployments.",
+	}, []string{LabelReason})
+
+	// InstanceAvailability is the availability of an instance as determined by the health watcher (1 = available, 0 = unavailable).
+	InstanceAvailability = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: availabilitySubsystem,
+		Name:      "instance_available",
+		Help:      "Availability of the landscaper of an instance (1 = available, 0 = unavailable).",
+	}, []string{LabelNamespace, LabelName})
+
+	// SelfAvailability is the availability of the landscaper of the landscaper service itself (1 = available, 0 = unavailable).
+	SelfAvailability = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: availabilitySubsystem,
+		Name:      "self_available",
+		Help:      "Availability of the landscaper of the landscaper service itself (1 = available, 0 = unavailable).",
+	})
+
+	// AvsUploads is the number of uploads of availability information to the availability service.
+	AvsUploads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: avsUploadSubsystem,
+		Name:      "total",
+		Help:      "Number of uploads of availability information to the availability service.",
+	}, []string{LabelResult})
+
+	// AvsUploadDuration is the duration of uploads of availability information to the availability service.
+	AvsUploadDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: avsUploadSubsystem,
+		Name:      "duration_seconds",
+		Help:      "Duration of uploads of availability information to the availability service.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{LabelResult})
+
+	// DeletionDuration is the duration from the deletion timestamp until the finalizer of a resource has been removed.
+	DeletionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Name:      "deletion_duration_seconds",
+		Help:      "Duration from the deletion timestamp until all resources of an object have been deleted and its finalizer has been removed.",
+		Buckets:   []float64{10, 30, 60, 120, 300, 600, 1200, 1800, 3600, 7200},
+	}, []string{LabelKind})
+
+	// UpgradesInProgress is the number of instances whose upgrade to the default version is in progress.
+	UpgradesInProgress = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: upgradeSubsystem,
+		Name:      "in_progress",
+		Help:      "Number of instances whose upgrade to the default landscaper service component version is in progress.",
+	})
+
+	// UpgradesHalted indicates whether the upgrade to the default version is halted because of unhealthy upgraded instances (1 = halted).
+	UpgradesHalted = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: upgradeSubsystem,
+		Name:      "halted",
+		Help:      "Whether the upgrade to the default landscaper service component version is halted because of unhealthy upgraded instances (1 = halted).",
+	})
+
+	// UpgradesApproved is the number of approved instance upgrades.
+	UpgradesApproved = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: upgradeSubsystem,
+		Name:      "approved_total",
+		Help:      "Number of approved upgrades of instances to the default landscaper service component version.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		ServiceTargetConfigInstances,
+		ServiceTargetConfigInstancesByPhase,
+		ServiceTargetConfigRequestedCPU,
+		ServiceTargetConfigRequestedMemory,
+		ServiceTargetConfigAllocatableCPU,
+		ServiceTargetConfigAllocatableMemory,
+		SchedulingDecisions,
+		SchedulingFailures,
+		InstanceAvailability,
+		SelfAvailability,
+		AvsUploads,
+		AvsUploadDuration,
+		DeletionDuration,
+		UpgradesInProgress,
+		UpgradesHalted,
+		UpgradesApproved,
+	)
+}
+
+// DeleteServiceTargetConfigMetrics removes all metrics of the given service target config.
+func DeleteServiceTargetConfigMetrics(namespace, name string) {
+	labels := prometheus.Labels{LabelNamespace: namespace, LabelName: name}
+	ServiceTargetConfigInstances.DeletePartialMatch(labels)
+	ServiceTargetConfigInstancesByPhase.DeletePartialMatch(labels)
+	ServiceTargetConfigRequestedCPU.DeletePartialMatch(labels)
+	ServiceTargetConfigRequestedMemory.DeletePartialMatch(labels)
+	ServiceTargetConfigAllocatableCPU.DeletePartialMatch(labels)
+	ServiceTargetConfigAllocatableMemory.DeletePartialMatch(labels)
+}
+
+// ObserveDeletionDuration records the time since the deletion timestamp of the given object.
+// Objects without a deletion timestamp are ignored.
+func ObserveDeletionDuration(kind string, obj metav1.Object) {
+	deletionTimestamp := obj.GetDeletionTimestamp()
+	if deletionTimestamp == nil {
+		return
+	}
+	DeletionDuration.WithLabelValues(kind).Observe(time.Since(deletionTimestamp.Time).Seconds())
+}
+
+// SetInstanceAvailability sets the availability of an instance according to the given health check status.
+// The availability of an instance with an unknown status is removed.
+func SetInstanceAvailability(namespace, name, status string) {
+	if value, ok := availabilityValue(status); ok {
+		InstanceAvailability.WithLabelValues(namespace, name).Set(value)
+	} else {
+		InstanceAvailability.DeleteLabelValues(namespace, name)
+	}
+}
+
+// SetSelfAvailability sets the availability of the landscaper of the landscaper service itself
+// according to the given health check status.
+func SetSelfAvailability(status string) {
+	if value, ok := availabilityValue(status); ok {
+		SelfAvailability.Set(value)
+	}
+}
+
+// availabilityValue maps a health check status to the value of an availability gauge.
+func availabilityValue(status string) (float64, bool) {
+	switch status {
+	case string(lsv1alpha1.LsHealthCheckStatusOk):
+		return 1, true
+	case string(lsv1alpha1.LsHealthCheckStatusFailed):
+		return 0, true
+	default:
+		return 0, false
+	}
+}
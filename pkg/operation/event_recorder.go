@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operation
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// noopEventRecorder is the event recorder that is used if no event recorder has been set.
+// It discards all events.
+type noopEventRecorder struct{}
+
+var _ record.EventRecorder = noopEventRecorder{}
+
+func (noopEventRecorder) Event(_ runtime.Object, _, _, _ string) {}
+
+func (noopEventRecorder) Eventf(_ runtime.Object, _, _, _ string, _ ...interface{}) {}
+
+func (noopEventRecorder) AnnotatedEventf(_ runtime.Object, _ map[string]string, _, _, _ string, _ ...interface{}) {
+}
@@ -6,6 +6,7 @@ package operation
 
 import (
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
@@ -19,6 +20,8 @@ type Operation struct {
 	scheme *runtime.Scheme
 	// config is the configuration for the landscaper service controller
 	config *v1alpha1.LandscaperServiceConfiguration
+	// eventRecorder records kubernetes events for the reconciled objects
+	eventRecorder record.EventRecorder
 }
 
 // NewOperation creates a new Operation for the given values.
@@ -43,3 +46,17 @@ func (o *Operation) Scheme() *runtime.Scheme {
 func (o *Operation) Config() *v1alpha1.LandscaperServiceConfiguration {
 	return o.config
 }
+
+// SetEventRecorder sets the recorder which is used to emit kubernetes events.
+func (o *Operation) SetEventRecorder(eventRecorder record.EventRecorder) {
+	o.eventRecorder = eventRecorder
+}
+
+// EventRecorder returns the kubernetes event recorder.
+// If no event recorder has been set, the events are discarded.
+func (o *Operation) EventRecorder() record.EventRecorder {
+	if o.eventRecorder == nil {
+		return noopEventRecorder{}
+	}
+	return o.eventRecorder
+}
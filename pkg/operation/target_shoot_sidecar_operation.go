@@ -6,6 +6,7 @@ package operation
 
 import (
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
@@ -19,6 +20,8 @@ type TargetShootSidecarOperation struct {
 	scheme *runtime.Scheme
 	// config is the configuration for the landscaper service controller
 	config *v1alpha1.TargetShootSidecarConfiguration
+	// eventRecorder records kubernetes events for the reconciled objects
+	eventRecorder record.EventRecorder
 }
 
 // NewTargetShootSidecarOperation creates a new TargetShootSidecarOperation for the given values.
@@ -43,3 +46,17 @@ func (o *TargetShootSidecarOperation) Scheme() *runtime.Scheme {
 func (o *TargetShootSidecarOperation) Config() *v1alpha1.TargetShootSidecarConfiguration {
 	return o.config
 }
+
+// SetEventRecorder sets the recorder which is used to emit kubernetes events.
+func (o *TargetShootSidecarOperation) SetEventRecorder(eventRecorder record.EventRecorder) {
+	o.eventRecorder = eventRecorder
+}
+
+// EventRecorder returns the kubernetes event recorder.
+// If no event recorder has been set, the events are discarded.
+func (o *TargetShootSidecarOperation) EventRecorder() record.EventRecorder {
+	if o.eventRecorder == nil {
+		return noopEventRecorder{}
+	}
+	return o.eventRecorder
+}
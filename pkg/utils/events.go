@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+)
+
+// RecordErrorEvent records a warning event for the given error.
+// The reason of a landscaper service error is used as event reason, otherwise the given default reason is used.
+// No event is recorded for conflict errors, as they are resolved by the next reconcile.
+func RecordErrorEvent(recorder record.EventRecorder, object runtime.Object, defaultReason string, err error) {
+	if err == nil || apierrors.IsConflict(err) {
+		return
+	}
+
+	reason := defaultReason
+	message := err.Error()
+	if lssErr, ok := lsserrors.IsError(err); ok {
+		lsErr := lssErr.LandscaperServiceError()
+		reason = lsErr.Reason
+		if len(lsErr.Message) > 0 {
+			message = lsErr.Message
+		}
+	}
+
+	recorder.Event(object, corev1.EventTypeWarning, reason, message)
+}
+
+// RecordConditionEvents records an event for each condition whose status has changed to True or False.
+// Conditions with status True result in a normal event, conditions with status False in a warning event.
+func RecordConditionEvents(recorder record.EventRecorder, object runtime.Object, oldConditions, newConditions []metav1.Condition) {
+	for _, condition := range newConditions {
+		if condition.Status == metav1.ConditionUnknown {
+			continue
+		}
+
+		oldCondition := meta.FindStatusCondition(oldConditions, condition.Type)
+		if oldCondition != nil && oldCondition.Status == condition.Status {
+			continue
+		}
+
+		eventType := corev1.EventTypeNormal
+		if condition.Status == metav1.ConditionFalse {
+			eventType = corev1.EventTypeWarning
+		}
+		recorder.Eventf(object, eventType, condition.Reason, "%s: %s", condition.Type, condition.Message)
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	lsserrors "github.com/gardener/landscaper-service/pkg/apis/errors"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+var _ = Describe("Events", func() {
+	var recorder *record.FakeRecorder
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should record a warning event with the reason of a landscaper service error", func() {
+		instance := &lssv1alpha1.Instance{}
+
+		utils.RecordErrorEvent(recorder, instance, "ReconcileFailed", lsserrors.NewWrappedError(fmt.Errorf("internal"), "Reconcile", "ReconcileContextFailed", "unable to create context"))
+		Expect(recorder.Events).To(Receive(Equal("Warning ReconcileContextFailed unable to create context")))
+
+		utils.RecordErrorEvent(recorder, instance, "ReconcileFailed", fmt.Errorf("some error"))
+		Expect(recorder.Events).To(Receive(Equal("Warning ReconcileFailed some error")))
+
+		utils.RecordErrorEvent(recorder, instance, "ReconcileFailed", nil)
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+	It("should not record an event for a conflict error", func() {
+		instance := &lssv1alpha1.Instance{}
+		conflict := apierrors.NewConflict(schema.GroupResource{Resource: "instances"}, "test", fmt.Errorf("object has been modified"))
+
+		utils.RecordErrorEvent(recorder, instance, "ReconcileFailed", conflict)
+		Expect(recorder.Events).ToNot(Receive())
+
+		utils.RecordErrorEvent(recorder, instance, "ReconcileFailed", lsserrors.NewWrappedError(conflict, "Reconcile", "UpdateInstance", conflict.Error()))
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+	It("should record events for changed conditions", func() {
+		instance := &lssv1alpha1.Instance{}
+		oldConditions := []metav1.Condition{
+			{Type: "ContextReady", Status: metav1.ConditionTrue, Reason: "ContextReconciled", Message: "ok"},
+			{Type: "InstallationSucceeded", Status: metav1.ConditionUnknown, Reason: "InstallationProgressing", Message: "progressing"},
+		}
+		newConditions := []metav1.Condition{
+			{Type: "ContextReady", Status: metav1.ConditionTrue, Reason: "ContextReconciled", Message: "ok"},
+			{Type: "InstallationSucceeded", Status: metav1.ConditionFalse, Reason: "InstallationFailed", Message: "failed"},
+			{Type: "Ready", Status: metav1.ConditionUnknown, Reason: "Progressing", Message: "waiting"},
+		}
+
+		utils.RecordConditionEvents(recorder, instance, oldConditions, newConditions)
+		Expect(recorder.Events).To(Receive(Equal("Warning InstallationFailed InstallationSucceeded: failed")))
+		Expect(recorder.Events).ToNot(Receive())
+	})
+})
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+var (
+	// cronParser parses cron expressions in the format "minute hour day-of-month month day-of-week".
+	cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+	// hibernationScheduleSearchPeriods are the periods in which the last activation of a hibernation schedule is searched,
+	// one after the other. They cover schedules with a daily up to a yearly recurrence, including the 29th of february.
+	hibernationScheduleSearchPeriods = []time.Duration{
+		24 * time.Hour,
+		8 * 24 * time.Hour,
+		32 * 24 * time.Hour,
+		367 * 24 * time.Hour,
+		4 * 367 * 24 * time.Hour,
+	}
+)
+
+// ParseCronSchedule parses a cron expression in the format "minute hour day-of-month month day-of-week".
+// Each field may contain "*", values, names, ranges and steps, separated by commas, e.g. "0 20 * * MON-FRI" or "*/15 8-18 * * 1,3,5".
+func ParseCronSchedule(expr string) (cron.Schedule, error) {
+	schedule, err := cronParser.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+	return schedule, nil
+}
+
+// previousActivation returns the last activation of the cron schedule at or before the given time.
+func previousActivation(schedule cron.Schedule, t time.Time) (time.Time, bool) {
+	for _, period := range hibernationScheduleSearchPeriods {
+		var last time.Time
+		for next := schedule.Next(t.Add(-period)); !next.IsZero() && !next.After(t); next = schedule.Next(next) {
+			last = next
+		}
+		if !last.IsZero() {
+			return last, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parsedHibernationSchedule is a hibernation schedule with parsed cron expressions.
+type parsedHibernationSchedule struct {
+	start    cron.Schedule
+	end      cron.Schedule
+	location *time.Location
+}
+
+// parseHibernationSchedule parses the cron expressions and the location of a hibernation schedule.
+func parseHibernationSchedule(schedule *lssv1alpha1.HibernationSchedule) (*parsedHibernationSchedule, error) {
+	var (
+		parsed = &parsedHibernationSchedule{location: time.UTC}
+		err    error
+	)
+	if parsed.start, err = ParseCronSchedule(schedule.Start); err != nil {
+		return nil, fmt.Errorf("invalid start: %w", err)
+	}
+	if parsed.end, err = ParseCronSchedule(schedule.End); err != nil {
+		return nil, fmt.Errorf("invalid end: %w", err)
+	}
+	if len(schedule.Location) > 0 {
+		if parsed.location, err = time.LoadLocation(schedule.Location); err != nil {
+			return nil, fmt.Errorf("invalid location %q: %w", schedule.Location, err)
+		}
+	}
+	return parsed, nil
+}
+
+// IsHibernated returns whether an instance with the given hibernation is hibernated at the given time.
+// A manually enabled or disabled hibernation takes precedence over the schedules.
+// Otherwise, the instance is hibernated if the last start of any schedule is later than its last end.
+func IsHibernated(hibernation *lssv1alpha1.Hibernation, now time.Time) (bool, error) {
+	if hibernation == nil {
+		return false, nil
+	}
+	if hibernation.Enabled != nil {
+		return *hibernation.Enabled, nil
+	}
+
+	for i := range hibernation.Schedules {
+		schedule, err := parseHibernationSchedule(&hibernation.Schedules[i])
+		if err != nil {
+			return false, err
+		}
+
+		localNow := now.In(schedule.location)
+		lastStart, ok := previousActivation(schedule.start, localNow)
+		if !ok {
+			continue
+		}
+		lastEnd, ok := previousActivation(schedule.end, localNow)
+		if !ok || lastStart.After(lastEnd) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// DurationUntilNextHibernationTransition returns the duration from the given time until the next start or end of a hibernation schedule.
+// False is returned if the hibernation is not controlled by schedules or no schedule is activated anymore.
+func DurationUntilNextHibernationTransition(hibernation *lssv1alpha1.Hibernation, now time.Time) (time.Duration, bool) {
+	if hibernation == nil || hibernation.Enabled != nil {
+		return 0, false
+	}
+
+	var next time.Time
+	for i := range hibernation.Schedules {
+		schedule, err := parseHibernationSchedule(&hibernation.Schedules[i])
+		if err != nil {
+			continue
+		}
+
+		localNow := now.In(schedule.location)
+		for _, cronSchedule := range []cron.Schedule{schedule.start, schedule.end} {
+			if t := cronSchedule.Next(localNow); !t.IsZero() && (next.IsZero() || t.Before(next)) {
+				next = t
+			}
+		}
+	}
+
+	if next.IsZero() {
+		return 0, false
+	}
+	return next.Sub(now), true
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+var _ = Describe("Hibernation", func() {
+	// 2024-03-01 is a friday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 3, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	weekdays := &lssv1alpha1.Hibernation{
+		Schedules: []lssv1alpha1.HibernationSchedule{
+			{Start: "0 20 * * MON-FRI", End: "0 6 * * MON-FRI"},
+		},
+	}
+
+	It("should parse cron expressions", func() {
+		schedule, err := utils.ParseCronSchedule("*/15 8-18 * * 1,3,5")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(at(1, 8, 30))).To(Equal(at(1, 8, 45)))
+		Expect(schedule.Next(at(1, 18, 0))).To(Equal(at(1, 18, 15)))
+		// the next activation after friday evening is on monday morning
+		Expect(schedule.Next(at(1, 18, 45))).To(Equal(at(4, 8, 0)))
+
+		schedule, err = utils.ParseCronSchedule("0 0 1 JAN SUN")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(time.Date(2023, 12, 31, 12, 0, 0, 0, time.UTC))).To(Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(schedule.Next(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))).To(Equal(time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)))
+
+		for _, expr := range []string{"0 20 * *", "60 * * * *", "0 20 * * MON-", "0 20 * * FRI-MON", "*/0 * * * *", "@daily"} {
+			_, err = utils.ParseCronSchedule(expr)
+			Expect(err).To(HaveOccurred(), expr)
+		}
+	})
+
+	It("should determine whether an instance is hibernated by its schedules", func() {
+		hibernated, err := utils.IsHibernated(weekdays, at(1, 12, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hibernated).To(BeFalse())
+
+		hibernated, err = utils.IsHibernated(weekdays, at(1, 20, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hibernated).To(BeTrue())
+
+		// the instance stays hibernated over the weekend
+		hibernated, err = utils.IsHibernated(weekdays, at(3, 12, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hibernated).To(BeTrue())
+
+		hibernated, err = utils.IsHibernated(weekdays, at(4, 6, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hibernated).To(BeFalse())
+	})
+
+	It("should determine whether an instance is hibernated by monthly schedules", func() {
+		hibernation := &lssv1alpha1.Hibernation{
+			Schedules: []lssv1alpha1.HibernationSchedule{
+				{Start: "0 0 1 * *", End: "0 0 8 * *"},
+			},
+		}
+
+		hibernated, err := utils.IsHibernated(hibernation, at(5, 12, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hibernated).To(BeTrue())
+
+		hibernated, err = utils.IsHibernated(hibernation, at(20, 12, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hibernated).To(BeFalse())
+
+		d, ok := utils.DurationUntilNextHibernationTransition(hibernation, at(20, 0, 0))
+		Expect(ok).To(BeTrue())
+		Expect(d).To(Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC).Sub(at(20, 0, 0))))
+	})
+
+	It("should evaluate the schedules in their location", func() {
+		hibernation := &lssv1alpha1.Hibernation{
+			Schedules: []lssv1alpha1.HibernationSchedule{
+				{Start: "0 20 * * *", End: "0 6 * * *", Location: "Europe/Berlin"},
+			},
+		}
+
+		hibernated, err := utils.IsHibernated(hibernation, at(1, 18, 59))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hibernated).To(BeFalse())
+
+		hibernated, err = utils.IsHibernated(hibernation, at(1, 19, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hibernated).To(BeTrue())
+
+		hibernation.Schedules[0].Location = "Nowhere/Unknown"
+		_, err = utils.IsHibernated(hibernation, at(1, 19, 0))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should prefer a manual hibernation over the schedules", func() {
+		hibernation := weekdays.DeepCopy()
+		hibernation.Enabled = ptr.To(false)
+
+		hibernated, err := utils.IsHibernated(hibernation, at(1, 20, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hibernated).To(BeFalse())
+
+		hibernation.Enabled = ptr.To(true)
+		hibernated, err = utils.IsHibernated(hibernation, at(1, 12, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hibernated).To(BeTrue())
+
+		_, ok := utils.DurationUntilNextHibernationTransition(hibernation, at(1, 12, 0))
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should compute the duration until the next transition", func() {
+		d, ok := utils.DurationUntilNextHibernationTransition(weekdays, at(1, 12, 0))
+		Expect(ok).To(BeTrue())
+		Expect(d).To(Equal(8 * time.Hour))
+
+		d, ok = utils.DurationUntilNextHibernationTransition(weekdays, at(1, 20, 0))
+		Expect(ok).To(BeTrue())
+		Expect(d).To(Equal(58 * time.Hour))
+	})
+})
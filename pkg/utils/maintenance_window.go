@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// MaintenanceWindowTimeFormat is the format of the begin and end of a maintenance window.
+const MaintenanceWindowTimeFormat = "150405-0700"
+
+const secondsPerDay = 24 * 60 * 60
+
+// ParseMaintenanceWindowTime parses the begin or end of a maintenance window
+// and returns the seconds since midnight in UTC.
+func ParseMaintenanceWindowTime(value string) (int, error) {
+	t, err := time.Parse(MaintenanceWindowTimeFormat, value)
+	if err != nil {
+		return 0, fmt.Errorf("time %q must have the format HHMMSS+ZONE: %w", value, err)
+	}
+	t = t.UTC()
+	return t.Hour()*60*60 + t.Minute()*60 + t.Second(), nil
+}
+
+// DurationUntilMaintenanceWindow returns the duration from the given time until the next begin of the maintenance window.
+// If the given time is inside the maintenance window, zero is returned.
+func DurationUntilMaintenanceWindow(window *lssv1alpha1.MaintenanceWindow, now time.Time) (time.Duration, error) {
+	begin, err := ParseMaintenanceWindowTime(window.Begin)
+	if err != nil {
+		return 0, err
+	}
+	end, err := ParseMaintenanceWindowTime(window.End)
+	if err != nil {
+		return 0, err
+	}
+
+	now = now.UTC()
+	current := now.Hour()*60*60 + now.Minute()*60 + now.Second()
+
+	var inside bool
+	if begin < end {
+		inside = current >= begin && current < end
+	} else {
+		// the maintenance window spans midnight
+		inside = current >= begin || current < end
+	}
+	if inside {
+		return 0, nil
+	}
+
+	return time.Duration((begin-current+secondsPerDay)%secondsPerDay) * time.Second, nil
+}
+
+// IsInMaintenanceWindow returns whether the given time is inside the maintenance window.
+func IsInMaintenanceWindow(window *lssv1alpha1.MaintenanceWindow, now time.Time) (bool, error) {
+	d, err := DurationUntilMaintenanceWindow(window, now)
+	if err != nil {
+		return false, err
+	}
+	return d == 0, nil
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+var _ = Describe("Maintenance Window", func() {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 3, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	It("should determine whether a time is inside a maintenance window", func() {
+		window := &lssv1alpha1.MaintenanceWindow{Begin: "220000+0100", End: "230000+0100"}
+
+		inside, err := utils.IsInMaintenanceWindow(window, at(21, 30))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inside).To(BeTrue())
+
+		inside, err = utils.IsInMaintenanceWindow(window, at(22, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inside).To(BeFalse())
+
+		d, err := utils.DurationUntilMaintenanceWindow(window, at(20, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(d).To(Equal(time.Hour))
+
+		d, err = utils.DurationUntilMaintenanceWindow(window, at(22, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(d).To(Equal(23 * time.Hour))
+	})
+
+	It("should handle maintenance windows spanning midnight", func() {
+		window := &lssv1alpha1.MaintenanceWindow{Begin: "230000+0000", End: "020000+0000"}
+
+		inside, err := utils.IsInMaintenanceWindow(window, at(23, 30))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inside).To(BeTrue())
+
+		inside, err = utils.IsInMaintenanceWindow(window, at(1, 59))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inside).To(BeTrue())
+
+		inside, err = utils.IsInMaintenanceWindow(window, at(2, 0))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inside).To(BeFalse())
+	})
+
+	It("should return an error for an invalid maintenance window", func() {
+		_, err := utils.IsInMaintenanceWindow(&lssv1alpha1.MaintenanceWindow{Begin: "22:00", End: "230000+0000"}, at(0, 0))
+		Expect(err).To(HaveOccurred())
+	})
+})
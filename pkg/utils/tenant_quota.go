@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// ListTenantQuotas returns the tenant quotas of the given tenant in all namespaces.
+func ListTenantQuotas(ctx context.Context, c client.Client, tenantId string) ([]lssv1alpha1.TenantQuota, error) {
+	quotaList := &lssv1alpha1.TenantQuotaList{}
+	if err := c.List(ctx, quotaList); err != nil {
+		return nil, fmt.Errorf("unable to list tenant quotas: %w", err)
+	}
+
+	quotas := make([]lssv1alpha1.TenantQuota, 0)
+	for _, quota := range quotaList.Items {
+		if quota.Spec.TenantId == tenantId {
+			quotas = append(quotas, quota)
+		}
+	}
+	return quotas, nil
+}
+
+// ListTenantLandscaperDeployments returns the landscaper deployments of the given tenant in all namespaces.
+// Landscaper deployments which are being deleted are not returned.
+func ListTenantLandscaperDeployments(ctx context.Context, c client.Client, tenantId string) ([]lssv1alpha1.LandscaperDeployment, error) {
+	deploymentList := &lssv1alpha1.LandscaperDeploymentList{}
+	if err := c.List(ctx, deploymentList); err != nil {
+		return nil, fmt.Errorf("unable to list landscaper deployments: %w", err)
+	}
+
+	deployments := make([]lssv1alpha1.LandscaperDeployment, 0)
+	for _, deployment := range deploymentList.Items {
+		if deployment.Spec.TenantId == tenantId && deployment.DeletionTimestamp.IsZero() {
+			deployments = append(deployments, deployment)
+		}
+	}
+	return deployments, nil
+}
+
+// NewTenantQuotaUsage creates an empty tenant quota usage.
+func NewTenantQuotaUsage() lssv1alpha1.TenantQuotaResources {
+	return lssv1alpha1.TenantQuotaResources{
+		Instances:                 ptr.To(0),
+		HighAvailabilityInstances: ptr.To(0),
+		RequestedCPU:              resource.NewQuantity(0, resource.DecimalSI),
+		RequestedMemory:           resource.NewQuantity(0, resource.BinarySI),
+	}
+}
+
+// AddTenantQuotaUsage adds the usage of a landscaper deployment to the given tenant quota usage,
+// which has been created with NewTenantQuotaUsage.
+func AddTenantQuotaUsage(usage *lssv1alpha1.TenantQuotaResources, deployment *lssv1alpha1.LandscaperDeployment) error {
+	*usage.Instances++
+	if deployment.Spec.HighAvailabilityConfig != nil {
+		*usage.HighAvailabilityInstances++
+	}
+
+	resources := deployment.Spec.LandscaperConfiguration.Resources
+	if resources == nil {
+		return nil
+	}
+	if len(resources.Requests.CPU) > 0 {
+		cpu, err := resource.ParseQuantity(resources.Requests.CPU)
+		if err != nil {
+			return fmt.Errorf("invalid cpu request of landscaper deployment %s: %w", client.ObjectKeyFromObject(deployment).String(), err)
+		}
+		usage.RequestedCPU.Add(cpu)
+	}
+	if len(resources.Requests.Memory) > 0 {
+		memory, err := resource.ParseQuantity(resources.Requests.Memory)
+		if err != nil {
+			return fmt.Errorf("invalid memory request of landscaper deployment %s: %w", client.ObjectKeyFromObject(deployment).String(), err)
+		}
+		usage.RequestedMemory.Add(memory)
+	}
+	return nil
+}
+
+// ExceededTenantQuotaLimits returns a description of each limit, which is exceeded by the given usage.
+// If a previous usage is given, a limit is only reported if the usage has also increased compared to the previous usage.
+// This allows changing landscaper deployments of a tenant, whose usage already exceeds a limit that has been lowered afterwards.
+func ExceededTenantQuotaLimits(limits, usage, previousUsage *lssv1alpha1.TenantQuotaResources) []string {
+	exceeded := make([]string, 0)
+
+	exceedsInt := func(limit, used, previous *int) bool {
+		return limit != nil && used != nil && *used > *limit && (previous == nil || *used > *previous)
+	}
+	exceedsQuantity := func(limit, used, previous *resource.Quantity) bool {
+		return limit != nil && used != nil && used.Cmp(*limit) > 0 && (previous == nil || used.Cmp(*previous) > 0)
+	}
+
+	var previous lssv1alpha1.TenantQuotaResources
+	if previousUsage != nil {
+		previous = *previousUsage
+	}
+
+	if exceedsInt(limits.Instances, usage.Instances, previous.Instances) {
+		exceeded = append(exceeded, fmt.Sprintf("instances: used %d, limited to %d", *usage.Instances, *limits.Instances))
+	}
+	if exceedsInt(limits.HighAvailabilityInstances, usage.HighAvailabilityInstances, previous.HighAvailabilityInstances) {
+		exceeded = append(exceeded, fmt.Sprintf("highAvailabilityInstances: used %d, limited to %d", *usage.HighAvailabilityInstances, *limits.HighAvailabilityInstances))
+	}
+	if exceedsQuantity(limits.RequestedCPU, usage.RequestedCPU, previous.RequestedCPU) {
+		exceeded = append(exceeded, fmt.Sprintf("requestedCPU: used %s, limited to %s", usage.RequestedCPU.String(), limits.RequestedCPU.String()))
+	}
+	if exceedsQuantity(limits.RequestedMemory, usage.RequestedMemory, previous.RequestedMemory) {
+		exceeded = append(exceeded, fmt.Sprintf("requestedMemory: used %s, limited to %s", usage.RequestedMemory.String(), limits.RequestedMemory.String()))
+	}
+
+	return exceeded
+}
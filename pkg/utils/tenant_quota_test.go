@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/utils"
+)
+
+var _ = Describe("TenantQuota", func() {
+	newDeployment := func(ha bool, cpu, memory string) *lssv1alpha1.LandscaperDeployment {
+		deployment := &lssv1alpha1.LandscaperDeployment{}
+		if ha {
+			deployment.Spec.HighAvailabilityConfig = &lssv1alpha1.HighAvailabilityConfig{ControlPlaneFailureTolerance: "zone"}
+		}
+		deployment.Spec.LandscaperConfiguration.Resources = &lssv1alpha1.Resources{
+			Requests: lssv1alpha1.ResourceRequests{CPU: cpu, Memory: memory},
+		}
+		return deployment
+	}
+
+	It("should aggregate the usage of landscaper deployments", func() {
+		usage := utils.NewTenantQuotaUsage()
+		Expect(utils.AddTenantQuotaUsage(&usage, newDeployment(false, "500m", "1Gi"))).To(Succeed())
+		Expect(utils.AddTenantQuotaUsage(&usage, newDeployment(true, "", "512Mi"))).To(Succeed())
+		Expect(utils.AddTenantQuotaUsage(&usage, &lssv1alpha1.LandscaperDeployment{})).To(Succeed())
+
+		Expect(*usage.Instances).To(Equal(3))
+		Expect(*usage.HighAvailabilityInstances).To(Equal(1))
+		Expect(usage.RequestedCPU.Cmp(resource.MustParse("500m"))).To(Equal(0))
+		Expect(usage.RequestedMemory.Cmp(resource.MustParse("1536Mi"))).To(Equal(0))
+
+		Expect(utils.AddTenantQuotaUsage(&usage, newDeployment(false, "invalid", ""))).ToNot(Succeed())
+	})
+
+	It("should report exceeded limits", func() {
+		limits := &lssv1alpha1.TenantQuotaResources{
+			Instances:       ptr.To(1),
+			RequestedMemory: ptr.To(resource.MustParse("1Gi")),
+		}
+
+		usage := utils.NewTenantQuotaUsage()
+		Expect(utils.AddTenantQuotaUsage(&usage, newDeployment(true, "4", "1Gi"))).To(Succeed())
+		Expect(utils.ExceededTenantQuotaLimits(limits, &usage, nil)).To(BeEmpty())
+
+		Expect(utils.AddTenantQuotaUsage(&usage, newDeployment(false, "", "1Mi"))).To(Succeed())
+		exceeded := utils.ExceededTenantQuotaLimits(limits, &usage, nil)
+		Expect(exceeded).To(HaveLen(2))
+		Expect(exceeded[0]).To(HavePrefix("instances:"))
+		Expect(exceeded[1]).To(HavePrefix("requestedMemory:"))
+	})
+
+	It("should not report exceeded limits if the usage has not increased", func() {
+		limits := &lssv1alpha1.TenantQuotaResources{
+			Instances: ptr.To(0),
+		}
+
+		previous := utils.NewTenantQuotaUsage()
+		Expect(utils.AddTenantQuotaUsage(&previous, newDeployment(false, "", ""))).To(Succeed())
+		usage := *previous.DeepCopy()
+
+		Expect(utils.ExceededTenantQuotaLimits(limits, &usage, &previous)).To(BeEmpty())
+
+		Expect(utils.AddTenantQuotaUsage(&usage, newDeployment(false, "", ""))).To(Succeed())
+		Expect(utils.ExceededTenantQuotaLimits(limits, &usage, &previous)).To(HaveLen(1))
+	})
+})
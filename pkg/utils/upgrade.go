@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// InstalledVersion returns the landscaper service component version which is installed for the instance.
+// An empty string is returned if no installation has been created yet.
+func InstalledVersion(instance *lssv1alpha1.Instance) string {
+	if instance.Status.LandscaperServiceComponent == nil {
+		return ""
+	}
+	return instance.Status.LandscaperServiceComponent.Version
+}
+
+// UpgradeRequiresApproval returns whether the installation of the given version for the instance
+// must be approved by the upgrade controller.
+// This is the case for instances which do not select a version and already have another version installed.
+func UpgradeRequiresApproval(instance *lssv1alpha1.Instance, version string) bool {
+	installedVersion := InstalledVersion(instance)
+	return len(instance.Spec.Version) == 0 && len(installedVersion) > 0 && installedVersion != version
+}
+
+// IsUpgradeApproved returns whether the upgrade of the instance to the given version has been approved.
+func IsUpgradeApproved(instance *lssv1alpha1.Instance, version string) bool {
+	approvedVersion, ok := instance.GetAnnotations()[lssv1alpha1.LandscaperServiceUpgradeApprovedAnnotation]
+	return ok && approvedVersion == version
+}
@@ -117,6 +117,13 @@ func HasDeleteWithoutUninstallAnnotation(obj metav1.Object) bool {
 	return ok && v == "true"
 }
 
+// IsOrphaned returns true if the given object has the orphaned annotation,
+// i.e. the service target config of the object has been deleted.
+func IsOrphaned(obj metav1.Object) bool {
+	_, ok := obj.GetAnnotations()[lssv1alpha1.LandscaperServiceOrphanedAnnotation]
+	return ok
+}
+
 // GetMapValues returns a slice with the values of the given map.
 func GetMapValues[E comparable, F any](m map[E]F) []F {
 	values := make([]F, 0)
@@ -133,4 +133,14 @@ var _ = Describe("Utils", func() {
 		Expect(secret.ObjectMeta.Annotations).ToNot(HaveKeyWithValue(lssv1alpha1.LandscaperServiceOperationAnnotation, lssv1alpha1.LandscaperServiceOperationIgnore))
 		Expect(secret.ObjectMeta.Annotations).To(HaveKeyWithValue("someKey", "someVar"))
 	})
+
+	It("should detect orphaned objects", func() {
+		instance := &lssv1alpha1.Instance{}
+		Expect(utils.IsOrphaned(instance)).To(BeFalse())
+
+		instance.Annotations = map[string]string{
+			lssv1alpha1.LandscaperServiceOrphanedAnnotation: "test/default",
+		}
+		Expect(utils.IsOrphaned(instance)).To(BeTrue())
+	})
 })
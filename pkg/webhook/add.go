@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strings"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -19,6 +20,8 @@ import (
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+
+	lssconfig "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
 )
 
 // WebhookedResourceDefinition contains information about the resources that should be watched by the webhook
@@ -45,20 +48,63 @@ type Options struct {
 	ServiceNamespace string
 	// port of the service
 	ServicePort int32
+	// URL under which the webhook can be reached, used instead of the service if set
+	WebhookURL string
 	// LabelSelector that is used to filter all resources handled by this webhook
 	ObjectSelector metav1.LabelSelector
 	// the resources that should be handled by this webhook
 	WebhookedResources []WebhookedResourceDefinition
 	// certificates for the webhook
 	CABundle []byte
+	// the landscaper service component versions which can be selected by landscaper deployments and instances
+	SupportedVersions []string
+	// the names of the shoot profiles which can be selected by landscaper deployments and instances
+	ShootProfiles []string
+	// the kubernetes versions of the shoot profiles, the empty name denotes the default shoot configuration
+	ShootKubernetesVersions map[string]string
+	// the landscape-wide defaults which are applied by the mutating webhooks
+	Defaults *lssconfig.LandscaperServiceDefaults
+}
+
+// newWebhookRule creates the rule of the webhook for the given resource
+func newWebhookRule(elem WebhookedResourceDefinition) admissionregistrationv1.RuleWithOperations {
+	return admissionregistrationv1.RuleWithOperations{
+		Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+		Rule: admissionregistrationv1.Rule{
+			APIGroups:   []string{elem.APIGroup},
+			APIVersions: elem.APIVersions,
+			Resources:   []string{elem.ResourceName},
+		},
+	}
+}
+
+// newWebhookClientConfig creates the client config of the webhook for the given resource
+func newWebhookClientConfig(o Options, elem WebhookedResourceDefinition) admissionregistrationv1.WebhookClientConfig {
+	webhookPath := path.Join(o.WebhookBasePath, elem.ResourceName)
+	if len(o.WebhookURL) != 0 {
+		webhookURL := strings.TrimSuffix(o.WebhookURL, "/") + webhookPath
+		return admissionregistrationv1.WebhookClientConfig{
+			CABundle: o.CABundle,
+			URL:      &webhookURL,
+		}
+	}
+	return admissionregistrationv1.WebhookClientConfig{
+		CABundle: o.CABundle,
+		Service: &admissionregistrationv1.ServiceReference{
+			Namespace: o.ServiceNamespace,
+			Name:      o.ServiceName,
+			Path:      &webhookPath,
+			Port:      &o.ServicePort,
+		},
+	}
 }
 
 // UpdateValidatingWebhookConfiguration will create or update a ValidatingWebhookConfiguration
 func UpdateValidatingWebhookConfiguration(ctx context.Context, kubeClient client.Client, o Options) error {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "UpdateValidatingWebhookConfiguration"})
 
-	// do not deploy or update the webhook if no service name is given
-	if len(o.ServiceName) == 0 || len(o.ServiceNamespace) == 0 {
+	// do not deploy or update the webhook if neither a service name nor a url is given
+	if (len(o.ServiceName) == 0 || len(o.ServiceNamespace) == 0) && len(o.WebhookURL) == 0 {
 		return nil
 	}
 
@@ -74,31 +120,14 @@ func UpdateValidatingWebhookConfiguration(ctx context.Context, kubeClient client
 	vwcWebhooks := []admissionregistrationv1.ValidatingWebhook{}
 
 	for _, elem := range o.WebhookedResources {
-		rule := admissionregistrationv1.RuleWithOperations{
-			Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
-			Rule:       admissionregistrationv1.Rule{},
-		}
-		rule.Rule.APIGroups = []string{elem.APIGroup}
-		rule.Rule.APIVersions = elem.APIVersions
-		rule.Rule.Resources = []string{elem.ResourceName}
-		clientConfig := admissionregistrationv1.WebhookClientConfig{
-			CABundle: o.CABundle,
-		}
-		webhookPath := path.Join(o.WebhookBasePath, elem.ResourceName)
-		clientConfig.Service = &admissionregistrationv1.ServiceReference{
-			Namespace: o.ServiceNamespace,
-			Name:      o.ServiceName,
-			Path:      &webhookPath,
-			Port:      &o.ServicePort,
-		}
 		vwcWebhook := admissionregistrationv1.ValidatingWebhook{
 			Name:                    elem.ResourceName + o.WebhookNameSuffix,
 			SideEffects:             &noSideEffects,
 			FailurePolicy:           &failPolicy,
 			ObjectSelector:          &o.ObjectSelector,
 			AdmissionReviewVersions: []string{"v1"},
-			Rules:                   []admissionregistrationv1.RuleWithOperations{rule},
-			ClientConfig:            clientConfig,
+			Rules:                   []admissionregistrationv1.RuleWithOperations{newWebhookRule(elem)},
+			ClientConfig:            newWebhookClientConfig(o, elem),
 		}
 		vwcWebhooks = append(vwcWebhooks, vwcWebhook)
 	}
@@ -138,6 +167,76 @@ func DeleteValidatingWebhookConfiguration(ctx context.Context, kubeClient client
 	return nil
 }
 
+// UpdateMutatingWebhookConfiguration will create or update a MutatingWebhookConfiguration
+func UpdateMutatingWebhookConfiguration(ctx context.Context, kubeClient client.Client, o Options) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "UpdateMutatingWebhookConfiguration"})
+
+	// do not deploy or update the webhook if neither a service name nor a url is given
+	if (len(o.ServiceName) == 0 || len(o.ServiceNamespace) == 0) && len(o.WebhookURL) == 0 {
+		return nil
+	}
+
+	mwc := admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: o.WebhookConfigurationName,
+		},
+	}
+
+	// construct MutatingWebhookConfiguration
+	noSideEffects := admissionregistrationv1.SideEffectClassNone
+	failPolicy := admissionregistrationv1.Fail
+	neverReinvoke := admissionregistrationv1.NeverReinvocationPolicy
+	mwcWebhooks := []admissionregistrationv1.MutatingWebhook{}
+
+	for _, elem := range o.WebhookedResources {
+		mwcWebhook := admissionregistrationv1.MutatingWebhook{
+			Name:                    elem.ResourceName + o.WebhookNameSuffix,
+			SideEffects:             &noSideEffects,
+			FailurePolicy:           &failPolicy,
+			ReinvocationPolicy:      &neverReinvoke,
+			ObjectSelector:          &o.ObjectSelector,
+			AdmissionReviewVersions: []string{"v1"},
+			Rules:                   []admissionregistrationv1.RuleWithOperations{newWebhookRule(elem)},
+			ClientConfig:            newWebhookClientConfig(o, elem),
+		}
+		mwcWebhooks = append(mwcWebhooks, mwcWebhook)
+	}
+
+	logger.Info("Creating/updating MutatingWebhookConfiguration", lc.KeyResource, o.WebhookConfigurationName, lc.KeyResourceKind, "MutatingWebhookConfiguration")
+	_, err := ctrl.CreateOrUpdate(ctx, kubeClient, &mwc, func() error {
+		mwc.Webhooks = mwcWebhooks
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create/update MutatingWebhookConfiguration: %w", err)
+	}
+	logger.Info("MutatingWebhookConfiguration created/updated", lc.KeyResource, o.WebhookConfigurationName, lc.KeyResourceKind, "MutatingWebhookConfiguration")
+
+	return nil
+}
+
+// DeleteMutatingWebhookConfiguration deletes a MutatingWebhookConfiguration
+func DeleteMutatingWebhookConfiguration(ctx context.Context, kubeClient client.Client, name string) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "DeleteMutatingWebhookConfiguration"})
+
+	mwc := admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	logger.Info("Removing MutatingWebhookConfiguration, if it exists", lc.KeyResource, name, lc.KeyResourceKind, "MutatingWebhookConfiguration")
+	if err := kubeClient.Delete(ctx, &mwc); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("MutatingWebhookConfiguration not found", lc.KeyResource, name, lc.KeyResourceKind, "MutatingWebhookConfiguration")
+		} else {
+			return fmt.Errorf("unable to delete MutatingWebhookConfiguration %q: %w", name, err)
+		}
+	} else {
+		logger.Info("MutatingWebhookConfiguration deleted", lc.KeyResource, name, lc.KeyResourceKind, "MutatingWebhookConfiguration")
+	}
+	return nil
+}
+
 // RegisterWebhooks generates certificates and registers the webhooks to the manager
 // no-op if WebhookedResources in the given options is either nil or empty
 func RegisterWebhooks(ctx context.Context, webhookServer ctrlwebhook.Server, client client.Client, scheme *runtime.Scheme, o Options) error {
@@ -154,6 +253,9 @@ func RegisterWebhooks(ctx context.Context, webhookServer ctrlwebhook.Server, cli
 		if err != nil {
 			return fmt.Errorf("unable to register webhooks: %w", err)
 		}
+		val.SetSupportedVersions(o.SupportedVersions)
+		val.SetShootProfiles(o.ShootProfiles)
+		val.SetShootKubernetesVersions(o.ShootKubernetesVersions)
 
 		webhookPath := o.WebhookBasePath + elem.ResourceName
 		rsLogger.Info("Registering webhook", lc.KeyResource, elem.ResourceName, "path", webhookPath)
@@ -163,3 +265,27 @@ func RegisterWebhooks(ctx context.Context, webhookServer ctrlwebhook.Server, cli
 
 	return nil
 }
+
+// RegisterMutatingWebhooks registers the mutating webhooks to the manager
+// no-op if WebhookedResources in the given options is either nil or empty
+func RegisterMutatingWebhooks(ctx context.Context, webhookServer ctrlwebhook.Server, scheme *runtime.Scheme, o Options) error {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "RegisterMutatingWebhooks"})
+
+	if len(o.WebhookedResources) == 0 {
+		return nil
+	}
+
+	for _, elem := range o.WebhookedResources {
+		rsLogger := logger.WithName(elem.ResourceName)
+		mut, err := MutatorFromResourceType(rsLogger, scheme, elem.ResourceName, o.Defaults)
+		if err != nil {
+			return fmt.Errorf("unable to register mutating webhooks: %w", err)
+		}
+
+		webhookPath := o.WebhookBasePath + elem.ResourceName
+		rsLogger.Info("Registering mutating webhook", lc.KeyResource, elem.ResourceName, "path", webhookPath)
+		webhookServer.Register(webhookPath, &ctrlwebhook.Admission{Handler: mut})
+	}
+
+	return nil
+}
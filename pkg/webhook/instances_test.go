@@ -283,6 +283,39 @@ var _ = Describe("Instance", func() {
 		Expect(response.Allowed).To(BeFalse())
 	})
 
+	It("should deny a migration if the service target config ref has not been recorded in the status", func() {
+		testObj := createInstance("test", "lss-system")
+
+		testObj.Spec = lssv1alpha1.InstanceSpec{
+			TenantId: "test0001",
+			ID:       "inst0001",
+			ServiceTargetConfigRef: lssv1alpha1.ObjectReference{
+				Name:      "test",
+				Namespace: "lss-system",
+			},
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+			},
+		}
+
+		oldObject := testObj.DeepCopyObject()
+		testObj.Annotations = map[string]string{
+			lssv1alpha1.LandscaperServiceOperationAnnotation: lssv1alpha1.LandscaperServiceOperationMigrate,
+		}
+		testObj.Spec.ServiceTargetConfigRef = lssv1alpha1.ObjectReference{
+			Name:      "test1",
+			Namespace: "lss-system",
+		}
+
+		request := CreateAdmissionRequestUpdate(testObj, oldObject)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("status.serviceTargetConfigRef"))
+	})
+
 	It("should validate high availability config", func() {
 		testObj := createInstance("test", "lss-system")
 		testObj.Spec = lssv1alpha1.InstanceSpec{
@@ -495,4 +528,158 @@ var _ = Describe("Instance", func() {
 		Expect(response).ToNot(BeNil())
 		Expect(response.Allowed).To(BeFalse())
 	})
+
+	It("should deny a change of the shoot profile which lowers the installed kubernetes version", func() {
+		validator.SetShootProfiles([]string{"small", "large", "legacy"})
+		validator.SetShootKubernetesVersions(map[string]string{
+			"":       "1.29.4",
+			"large":  "1.30.1",
+			"legacy": "1.28.9",
+		})
+
+		testObj := createInstance("test", "lss-system")
+		testObj.Spec = lssv1alpha1.InstanceSpec{
+			TenantId: "test0001",
+			ID:       "inst0001",
+			ServiceTargetConfigRef: lssv1alpha1.ObjectReference{
+				Name:      "test",
+				Namespace: "lss-system",
+			},
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+			},
+			ShootProfile: "small",
+		}
+		testObj.Status.ShootKubernetesVersion = "1.29.4"
+		oldObj := testObj.DeepCopyObject()
+
+		// the shoot profile "small" uses the kubernetes version of the default shoot configuration
+		testObj.Spec.ShootProfile = "large"
+		request := CreateAdmissionRequestUpdate(testObj, oldObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		testObj.Spec.ShootProfile = ""
+		request = CreateAdmissionRequestUpdate(testObj, oldObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		testObj.Spec.ShootProfile = "legacy"
+		request = CreateAdmissionRequestUpdate(testObj, oldObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.shootProfile: Forbidden: selects kubernetes version 1.28.9, which is lower than the installed kubernetes version 1.29.4"))
+	})
+})
+
+var _ = Describe("Instance Migration", func() {
+	var (
+		validator webhook.GenericValidator
+		ctx       context.Context
+		state     *envtest.State
+	)
+
+	BeforeEach(func() {
+		var err error
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, webhook.InstancesResourceType)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+		state, err = testenv.InitResources(ctx, "./testdata/migration")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		defer ctx.Done()
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).ToNot(HaveOccurred())
+		}
+	})
+
+	// newMigration returns an instance which is migrated to the given service target config, and the instance before the migration.
+	newMigration := func(destination string) (*lssv1alpha1.Instance, *lssv1alpha1.Instance) {
+		oldObject := createInstance("test", state.Namespace)
+		oldObject.Spec = lssv1alpha1.InstanceSpec{
+			TenantId: "test0001",
+			ID:       "inst0001",
+			Region:   "eu",
+			ServiceTargetConfigRef: lssv1alpha1.ObjectReference{
+				Name:      "source",
+				Namespace: state.Namespace,
+			},
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+			},
+		}
+		oldObject.Status.ServiceTargetConfigRef = oldObject.Spec.ServiceTargetConfigRef.DeepCopy()
+
+		testObj := oldObject.DeepCopy()
+		testObj.Annotations = map[string]string{
+			lssv1alpha1.LandscaperServiceOperationAnnotation: lssv1alpha1.LandscaperServiceOperationMigrate,
+		}
+		testObj.Spec.ServiceTargetConfigRef = lssv1alpha1.ObjectReference{
+			Name:      destination,
+			Namespace: state.Namespace,
+		}
+		return testObj, oldObject
+	}
+
+	It("should allow an update of the service target config ref with migrate annotation", func() {
+		testObj, oldObject := newMigration("destination")
+
+		request := CreateAdmissionRequestUpdate(testObj, oldObject)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should deny a migration to a service target config which does not exist", func() {
+		testObj, oldObject := newMigration("missing")
+
+		request := CreateAdmissionRequestUpdate(testObj, oldObject)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("not found"))
+	})
+
+	It("should deny a migration to a cordoned service target config", func() {
+		testObj, oldObject := newMigration("cordoned")
+
+		request := CreateAdmissionRequestUpdate(testObj, oldObject)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("cordoned"))
+	})
+
+	It("should deny a migration to a service target config in another region", func() {
+		testObj, oldObject := newMigration("destination")
+		oldObject.Spec.Region = "us"
+		testObj.Spec.Region = "us"
+
+		request := CreateAdmissionRequestUpdate(testObj, oldObject)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("region"))
+	})
+
+	It("should deny changing the destination of a running migration", func() {
+		testObj, oldObject := newMigration("destination")
+		oldObject.Spec.ServiceTargetConfigRef.Name = "cordoned"
+
+		request := CreateAdmissionRequestUpdate(testObj, oldObject)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("is being migrated"))
+	})
 })
@@ -170,6 +170,35 @@ var _ = Describe("LandscaperDeployment", func() {
 		Expect(response.Allowed).To(BeFalse())
 	})
 
+	It("should deny an update of the region", func() {
+		testObj := createLandscaperDeployment("test", "lss-system")
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "test0001",
+			Purpose:  "test",
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+					"manifest",
+				},
+			},
+			Region: "eu-west-1",
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		oldObj := testObj.DeepCopyObject()
+		testObj.Spec.Region = "us-east-1"
+
+		request = CreateAdmissionRequestUpdate(testObj, oldObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.region"))
+	})
+
 	It("should validate high availability config", func() {
 		testObj := createLandscaperDeployment("test", "lss-system")
 		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
@@ -359,4 +388,292 @@ var _ = Describe("LandscaperDeployment", func() {
 		Expect(response).ToNot(BeNil())
 		Expect(response.Allowed).To(BeFalse())
 	})
+
+	It("shall validate the user kubeconfig secret name", func() {
+		testObj := createLandscaperDeployment("test", "lss-system")
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "test0001",
+			Purpose:  "test",
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+					"manifest",
+				},
+			},
+			UserKubeconfigSecretName: "my-kubeconfig",
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		testObj.Spec.UserKubeconfigSecretName = "My_Kubeconfig"
+		request = CreateAdmissionRequest(testObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+
+		testObj.Spec.UserKubeconfigSecretName = "my-kubeconfig"
+		testObj.Spec.DataPlane = &lssv1alpha1.DataPlane{
+			Kubeconfig: "{}",
+		}
+		request = CreateAdmissionRequest(testObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+	})
+
+	It("shall validate the hibernation schedules", func() {
+		testObj := createLandscaperDeployment("test", "lss-system")
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "test0001",
+			Purpose:  "test",
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+					"manifest",
+				},
+			},
+			Hibernation: &lssv1alpha1.Hibernation{
+				Schedules: []lssv1alpha1.HibernationSchedule{
+					{Start: "0 20 * * MON-FRI", End: "0 6 * * MON-FRI", Location: "Europe/Berlin"},
+				},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		testObj.Spec.Hibernation.Schedules[0].Start = "0 25 * * *"
+		request = CreateAdmissionRequest(testObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+
+		testObj.Spec.Hibernation.Schedules[0].Start = "0 20 * * MON-FRI"
+		testObj.Spec.Hibernation.Schedules[0].Location = "Nowhere/Unknown"
+		request = CreateAdmissionRequest(testObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+	})
+
+	It("shall validate the scheduling constraints", func() {
+		testObj := createLandscaperDeployment("test", "lss-system")
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "test0001",
+			Purpose:  "test",
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+					"manifest",
+				},
+			},
+			SchedulingConstraints: &lssv1alpha1.SchedulingConstraints{
+				SpreadConstraints: []lssv1alpha1.SpreadConstraint{
+					{TopologyKey: "topology.landscaper-service.gardener.cloud/zone"},
+				},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		testObj.Spec.SchedulingConstraints.SpreadConstraints[0].WhenUnsatisfiable = "Never"
+		request = CreateAdmissionRequest(testObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.schedulingConstraints.spreadConstraints[0].whenUnsatisfiable"))
+	})
+
+	It("shall validate the selected version against the supported versions", func() {
+		validator.SetSupportedVersions([]string{"v0.1.2", "v0.2.0"})
+
+		testObj := createLandscaperDeployment("test", "lss-system")
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "test0001",
+			Purpose:  "test",
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+			},
+			Version: "v0.1.2",
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		oldObj := testObj.DeepCopyObject()
+		testObj.Spec.Version = "v0.3.0"
+
+		request = CreateAdmissionRequestUpdate(testObj, oldObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.version"))
+
+		testObj.Spec.Version = "v0.2.0"
+
+		request = CreateAdmissionRequestUpdate(testObj, oldObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("shall deny a downgrade or the removal of the selected version", func() {
+		validator.SetSupportedVersions([]string{"v0.1.2", "v0.2.0"})
+
+		testObj := createLandscaperDeployment("test", "lss-system")
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "test0001",
+			Purpose:  "test",
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+			},
+			Version: "v0.2.0",
+		}
+
+		oldObj := testObj.DeepCopyObject()
+		testObj.Spec.Version = "v0.1.2"
+
+		request := CreateAdmissionRequestUpdate(testObj, oldObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("can not be downgraded"))
+
+		testObj.Spec.Version = ""
+
+		request = CreateAdmissionRequestUpdate(testObj, oldObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.version"))
+	})
+
+	It("shall deny the selection of a version if no versions are supported", func() {
+		testObj := createLandscaperDeployment("test", "lss-system")
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "test0001",
+			Purpose:  "test",
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+			},
+			Version: "v0.1.2",
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.version"))
+	})
+
+	It("shall validate the selected shoot profile against the shoot profiles", func() {
+		validator.SetShootProfiles([]string{"small", "large"})
+
+		testObj := createLandscaperDeployment("test", "lss-system")
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "test0001",
+			Purpose:  "test",
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+			},
+			ShootProfile: "large",
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		testObj.Spec.ShootProfile = "huge"
+
+		request = CreateAdmissionRequest(testObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.shootProfile"))
+
+		// an unchanged shoot profile remains valid, even if it is not offered anymore
+		testObj.Spec.ShootProfile = "large"
+		oldObj := testObj.DeepCopyObject()
+		validator.SetShootProfiles([]string{"small"})
+		testObj.Spec.Purpose = "production"
+
+		request = CreateAdmissionRequestUpdate(testObj, oldObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("shall allow a change of the shoot profile", func() {
+		validator.SetShootProfiles([]string{"small", "large"})
+		validator.SetShootKubernetesVersions(map[string]string{
+			"":      "1.29.4",
+			"large": "1.30.1",
+		})
+
+		testObj := createLandscaperDeployment("test", "lss-system")
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "test0001",
+			Purpose:  "test",
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+			},
+			ShootProfile: "small",
+		}
+
+		oldObj := testObj.DeepCopyObject()
+		testObj.Spec.ShootProfile = "large"
+
+		request := CreateAdmissionRequestUpdate(testObj, oldObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		testObj.Spec.ShootProfile = ""
+
+		request = CreateAdmissionRequestUpdate(testObj, oldObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("shall deny the selection of a shoot profile if no shoot profiles are configured", func() {
+		testObj := createLandscaperDeployment("test", "lss-system")
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "test0001",
+			Purpose:  "test",
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+			},
+			ShootProfile: "large",
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("does not support the selection of a shoot profile"))
+	})
 })
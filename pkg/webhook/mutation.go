@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssconfig "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+)
+
+// HasMutator returns whether there is a mutating webhook for the given resource type.
+func HasMutator(resource string) bool {
+	return resource == LandscaperDeploymentsResourceType || resource == InstancesResourceType
+}
+
+// MutatorFromResourceType is a helper method that gets a resource type and returns the fitting mutator
+func MutatorFromResourceType(log logging.Logger, scheme *runtime.Scheme, resource string, defaults *lssconfig.LandscaperServiceDefaults) (admission.Handler, error) {
+	abstrMut := newAbstractMutator(log, scheme, defaults)
+	var mut admission.Handler
+	if resource == LandscaperDeploymentsResourceType {
+		mut = &LandscaperDeploymentMutator{abstrMut}
+	} else if resource == InstancesResourceType {
+		mut = &InstanceMutator{abstrMut}
+	} else {
+		return nil, fmt.Errorf("unable to find mutator for resource type %q", resource)
+	}
+	return mut, nil
+}
+
+type abstractMutator struct {
+	decoder  runtime.Decoder
+	log      logging.Logger
+	defaults *lssconfig.LandscaperServiceDefaults
+}
+
+// newAbstractMutator creates a new abstract mutator
+func newAbstractMutator(log logging.Logger, scheme *runtime.Scheme, defaults *lssconfig.LandscaperServiceDefaults) abstractMutator {
+	if defaults == nil {
+		defaults = &lssconfig.LandscaperServiceDefaults{}
+	}
+	return abstractMutator{
+		decoder:  serializer.NewCodecFactory(scheme).UniversalDecoder(),
+		log:      log,
+		defaults: defaults,
+	}
+}
+
+// patchResponse creates a response, which patches the raw object of the request to the given mutated object.
+func patchResponse(req admission.Request, obj runtime.Object) admission.Response {
+	mutated, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, mutated)
+}
+
+// LANDSCAPER DEPLOYMENT
+
+// LandscaperDeploymentMutator represents a mutator for a LandscaperDeployment
+type LandscaperDeploymentMutator struct{ abstractMutator }
+
+// Handle handles a request to the webhook
+func (dm *LandscaperDeploymentMutator) Handle(_ context.Context, req admission.Request) admission.Response {
+	deployment := &lssv1alpha1.LandscaperDeployment{}
+	if _, _, err := dm.decoder.Decode(req.Object.Raw, nil, deployment); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !deployment.DeletionTimestamp.IsZero() {
+		return admission.Allowed("LandscaperDeployment is being deleted")
+	}
+
+	ApplyLandscaperConfigurationDefaults(&deployment.Spec.LandscaperConfiguration, dm.defaults.LandscaperConfiguration)
+	return patchResponse(req, deployment)
+}
+
+// INSTANCE
+
+// InstanceMutator represents a mutator for an Instance
+type InstanceMutator struct{ abstractMutator }
+
+// Handle handles a request to the webhook
+func (im *InstanceMutator) Handle(_ context.Context, req admission.Request) admission.Response {
+	instance := &lssv1alpha1.Instance{}
+	if _, _, err := im.decoder.Decode(req.Object.Raw, nil, instance); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return admission.Allowed("Instance is being deleted")
+	}
+
+	ApplyLandscaperConfigurationDefaults(&instance.Spec.LandscaperConfiguration, im.defaults.LandscaperConfiguration)
+	return patchResponse(req, instance)
+}
+
+// DEFAULTS
+
+// ApplyLandscaperConfigurationDefaults sets the fields of the landscaper configuration, which are not set, to the given defaults.
+// Resources and HPA settings are defaulted per field, the default deployers are only applied if no deployers are set.
+// The default deployer configs are only applied to the deployers of the landscaper configuration.
+func ApplyLandscaperConfigurationDefaults(config, defaults *lssv1alpha1.LandscaperConfiguration) {
+	if defaults == nil {
+		return
+	}
+
+	if config.Landscaper == nil && defaults.Landscaper != nil {
+		config.Landscaper = defaults.Landscaper.DeepCopy()
+	}
+	config.Resources = defaultResources(config.Resources, defaults.Resources)
+	config.ResourcesMain = defaultResources(config.ResourcesMain, defaults.ResourcesMain)
+	config.HPAMain = defaultHPA(config.HPAMain, defaults.HPAMain)
+
+	if len(config.Deployers) == 0 {
+		config.Deployers = append([]string{}, defaults.Deployers...)
+	}
+
+	for name, deployerDefaults := range defaults.DeployersConfig {
+		if deployerDefaults == nil || !slices.Contains(config.Deployers, name) {
+			continue
+		}
+		if config.DeployersConfig == nil {
+			config.DeployersConfig = make(map[string]*lssv1alpha1.DeployerConfig)
+		}
+
+		deployerConfig := config.DeployersConfig[name]
+		if deployerConfig == nil {
+			config.DeployersConfig[name] = deployerDefaults.DeepCopy()
+			continue
+		}
+		if deployerConfig.Deployer == nil && deployerDefaults.Deployer != nil {
+			deployerConfig.Deployer = deployerDefaults.Deployer.DeepCopy()
+		}
+		deployerConfig.Resources = defaultResources(deployerConfig.Resources, deployerDefaults.Resources)
+		deployerConfig.HPA = defaultHPA(deployerConfig.HPA, deployerDefaults.HPA)
+	}
+}
+
+func defaultResources(resources, defaults *lssv1alpha1.Resources) *lssv1alpha1.Resources {
+	if defaults == nil {
+		return resources
+	}
+	if resources == nil {
+		return defaults.DeepCopy()
+	}
+	if len(resources.Requests.CPU) == 0 {
+		resources.Requests.CPU = defaults.Requests.CPU
+	}
+	if len(resources.Requests.Memory) == 0 {
+		resources.Requests.Memory = defaults.Requests.Memory
+	}
+	return resources
+}
+
+func defaultHPA(hpa, defaults *lssv1alpha1.HPA) *lssv1alpha1.HPA {
+	if defaults == nil {
+		return hpa
+	}
+	if hpa == nil {
+		return defaults.DeepCopy()
+	}
+	if hpa.MaxReplicas == 0 {
+		hpa.MaxReplicas = defaults.MaxReplicas
+	}
+	if hpa.AverageCpuUtilization == 0 {
+		hpa.AverageCpuUtilization = defaults.AverageCpuUtilization
+	}
+	if hpa.AverageMemoryUtilization == 0 {
+		hpa.AverageMemoryUtilization = defaults.AverageMemoryUtilization
+	}
+	return hpa
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssconfig "github.com/gardener/landscaper-service/pkg/apis/config/v1alpha1"
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/webhook"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+var _ = Describe("Mutation", func() {
+	var (
+		defaults *lssconfig.LandscaperServiceDefaults
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		defaults = &lssconfig.LandscaperServiceDefaults{
+			LandscaperConfiguration: &lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{"helm", "manifest"},
+				Resources: &lssv1alpha1.Resources{
+					Requests: lssv1alpha1.ResourceRequests{
+						CPU:    "100m",
+						Memory: "200Mi",
+					},
+				},
+				HPAMain: &lssv1alpha1.HPA{
+					MaxReplicas:           3,
+					AverageCpuUtilization: 80,
+				},
+				DeployersConfig: map[string]*lssv1alpha1.DeployerConfig{
+					"helm": {
+						HPA: &lssv1alpha1.HPA{MaxReplicas: 2},
+					},
+					"container": {
+						HPA: &lssv1alpha1.HPA{MaxReplicas: 2},
+					},
+				},
+			},
+		}
+	})
+
+	It("should apply the defaults to unset fields", func() {
+		config := &lssv1alpha1.LandscaperConfiguration{
+			Resources: &lssv1alpha1.Resources{
+				Requests: lssv1alpha1.ResourceRequests{
+					CPU: "1",
+				},
+			},
+			HPAMain: &lssv1alpha1.HPA{
+				MaxReplicas: 5,
+			},
+		}
+
+		webhook.ApplyLandscaperConfigurationDefaults(config, defaults.LandscaperConfiguration)
+		Expect(config.Deployers).To(ConsistOf("helm", "manifest"))
+		Expect(config.Resources.Requests.CPU).To(Equal("1"))
+		Expect(config.Resources.Requests.Memory).To(Equal("200Mi"))
+		Expect(config.ResourcesMain).To(BeNil())
+		Expect(config.HPAMain.MaxReplicas).To(BeEquivalentTo(5))
+		Expect(config.HPAMain.AverageCpuUtilization).To(BeEquivalentTo(80))
+		Expect(config.DeployersConfig).To(HaveKey("helm"))
+		Expect(config.DeployersConfig).ToNot(HaveKey("container"))
+
+		// the defaults must not be modified
+		config.DeployersConfig["helm"].HPA.MaxReplicas = 10
+		Expect(defaults.LandscaperConfiguration.DeployersConfig["helm"].HPA.MaxReplicas).To(BeEquivalentTo(2))
+	})
+
+	It("should not override the deployers", func() {
+		config := &lssv1alpha1.LandscaperConfiguration{
+			Deployers: []string{"container"},
+		}
+
+		webhook.ApplyLandscaperConfigurationDefaults(config, defaults.LandscaperConfiguration)
+		Expect(config.Deployers).To(ConsistOf("container"))
+		Expect(config.DeployersConfig).To(HaveKey("container"))
+		Expect(config.DeployersConfig).ToNot(HaveKey("helm"))
+	})
+
+	It("should patch a landscaper deployment", func() {
+		mutator, err := webhook.MutatorFromResourceType(logging.Discard(), envtest.LandscaperServiceScheme, webhook.LandscaperDeploymentsResourceType, defaults)
+		Expect(err).ToNot(HaveOccurred())
+
+		testObj := createLandscaperDeployment("test", "lss-system")
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "test0001",
+			Purpose:  "test",
+		}
+
+		response := mutator.Handle(ctx, CreateAdmissionRequest(testObj))
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Patches).To(ContainElement(HaveField("Path", "/spec/landscaperConfiguration/deployers")))
+		Expect(response.Patches).To(ContainElement(HaveField("Path", "/spec/landscaperConfiguration/resources")))
+	})
+
+	It("should not patch an instance without unset fields", func() {
+		mutator, err := webhook.MutatorFromResourceType(logging.Discard(), envtest.LandscaperServiceScheme, webhook.InstancesResourceType, defaults)
+		Expect(err).ToNot(HaveOccurred())
+
+		testObj := createInstance("test", "lss-system")
+		testObj.Spec.LandscaperConfiguration = *defaults.LandscaperConfiguration.DeepCopy()
+		testObj.Spec.LandscaperConfiguration.ResourcesMain = &lssv1alpha1.Resources{}
+		delete(testObj.Spec.LandscaperConfiguration.DeployersConfig, "container")
+
+		response := mutator.Handle(ctx, CreateAdmissionRequest(testObj))
+		Expect(response.Allowed).To(BeTrue())
+		Expect(response.Patches).ToNot(ContainElement(HaveField("Path", HavePrefix("/spec"))))
+	})
+
+	It("should not provide mutators for other resources", func() {
+		Expect(webhook.HasMutator(webhook.ServiceTargetConfigsResourceType)).To(BeFalse())
+		_, err := webhook.MutatorFromResourceType(logging.Discard(), envtest.LandscaperServiceScheme, webhook.ServiceTargetConfigsResourceType, defaults)
+		Expect(err).To(HaveOccurred())
+	})
+})
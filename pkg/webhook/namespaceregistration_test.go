@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/webhook"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+func createNamespaceRegistration(name, namespace string) *lssv1alpha1.NamespaceRegistration {
+	namespaceRegistration := &lssv1alpha1.NamespaceRegistration{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NamespaceRegistration",
+			APIVersion: lssv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	return namespaceRegistration
+}
+
+var _ = Describe("NamespaceRegistration", func() {
+	var (
+		validator webhook.GenericValidator
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, webhook.NamespaceRegistrationsResourceType)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+	})
+
+	It("should allow valid resource", func() {
+		testObj := createNamespaceRegistration("cu-test", "ls-user")
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should deny resource without the customer namespace prefix", func() {
+		testObj := createNamespaceRegistration("test", "ls-user")
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("cu-"))
+	})
+
+	It("should deny resource with a name which isn't a valid namespace name", func() {
+		testObj := createNamespaceRegistration("cu-test.invalid", "ls-user")
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("metadata.name"))
+	})
+
+	It("should deny resource for an already existing namespace", func() {
+		namespace := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cu-existing",
+			},
+		}
+		Expect(testenv.Client.Create(ctx, namespace)).To(Succeed())
+		defer func() {
+			Expect(testenv.Client.Delete(ctx, namespace)).To(Succeed())
+		}()
+
+		testObj := createNamespaceRegistration("cu-existing", "ls-user")
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("already exists"))
+
+		request = CreateAdmissionRequestUpdate(testObj, testObj.DeepCopy())
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+})
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/webhook"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+func createRebalancePlan(name, namespace string) *lssv1alpha1.RebalancePlan {
+	plan := &lssv1alpha1.RebalancePlan{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "RebalancePlan",
+			APIVersion: lssv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	return plan
+}
+
+var _ = Describe("RebalancePlan", func() {
+	var (
+		validator webhook.GenericValidator
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, webhook.RebalancePlansResourceType)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+	})
+
+	It("should allow valid resource", func() {
+		testObj := createRebalancePlan("test", "lss-system")
+		testObj.Spec = lssv1alpha1.RebalancePlanSpec{
+			ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+				{Name: "default", Namespace: "lss-system"},
+			},
+			MaxMoves: ptr.To(5),
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should deny resource with invalid service target config reference", func() {
+		testObj := createRebalancePlan("test", "lss-system")
+		testObj.Spec = lssv1alpha1.RebalancePlanSpec{
+			ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+				{Name: "default"},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.serviceTargetConfigs[0].namespace"))
+	})
+
+	It("should deny resource with negative max moves", func() {
+		testObj := createRebalancePlan("test", "lss-system")
+		testObj.Spec = lssv1alpha1.RebalancePlanSpec{
+			MaxMoves: ptr.To(-1),
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.maxMoves"))
+	})
+})
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
@@ -180,4 +181,35 @@ var _ = Describe("ServiceTargetConfig", func() {
 
 		Expect(response.Result.Message).To(ContainSubstring("spec.ingressDomain"))
 	})
+
+	It("should deny resource with negative capacity limits", func() {
+		testObj := createServiceTargetConfig("test", "lss-system")
+
+		testObj.ObjectMeta.Labels = map[string]string{
+			lssv1alpha1.ServiceTargetConfigVisibleLabelName: "true",
+		}
+		testObj.Spec = lssv1alpha1.ServiceTargetConfigSpec{
+			Priority: 10,
+			SecretRef: lssv1alpha1.SecretReference{
+				ObjectReference: lssv1alpha1.ObjectReference{
+					Name:      "target",
+					Namespace: "lss-system",
+				},
+				Key: "kubeconfig",
+			},
+			IngressDomain:         "ingress.external",
+			MaxInstances:          ptr.To[int64](-1),
+			MaxInstancesPerTenant: ptr.To[int64](-1),
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result).ToNot(BeNil())
+		Expect(response.Result.Message).ToNot(BeNil())
+
+		Expect(response.Result.Message).To(ContainSubstring("spec.maxInstances"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.maxInstancesPerTenant"))
+	})
 })
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/webhook"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+func createSubjectList(name, namespace string) *lssv1alpha1.SubjectList {
+	subjectList := &lssv1alpha1.SubjectList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "SubjectList",
+			APIVersion: lssv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	return subjectList
+}
+
+var _ = Describe("SubjectList", func() {
+	var (
+		validator webhook.GenericValidator
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, webhook.SubjectListsResourceType)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+	})
+
+	It("should allow valid resource", func() {
+		testObj := createSubjectList("subjects", "ls-user")
+		testObj.Spec = lssv1alpha1.SubjectListSpec{
+			Subjects: []lssv1alpha1.Subject{
+				{Kind: "User", Name: "testuser@example.com"},
+				{Kind: "Group", Name: "testgroup"},
+				{Kind: "ServiceAccount", Name: "testserviceaccount"},
+			},
+			ViewerSubjects: []lssv1alpha1.Subject{
+				{Kind: "ServiceAccount", Name: "testviewer", Namespace: "cu-test"},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should deny resource with an unknown subject kind", func() {
+		testObj := createSubjectList("subjects", "ls-user")
+		testObj.Spec = lssv1alpha1.SubjectListSpec{
+			Subjects: []lssv1alpha1.Subject{
+				{Kind: "Robot", Name: "testrobot"},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.subjects[0].kind"))
+	})
+
+	It("should deny resource with invalid names and namespaces", func() {
+		testObj := createSubjectList("subjects", "ls-user")
+		testObj.Spec = lssv1alpha1.SubjectListSpec{
+			Subjects: []lssv1alpha1.Subject{
+				{Kind: "User"},
+			},
+			ViewerSubjects: []lssv1alpha1.Subject{
+				{Kind: "ServiceAccount", Name: "Invalid_Name"},
+				{Kind: "ServiceAccount", Name: "testviewer", Namespace: "invalid.namespace"},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.subjects[0].name"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.viewerSubjects[0].name"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.viewerSubjects[1].namespace"))
+	})
+})
@@ -204,4 +204,102 @@ var _ = Describe("TargetScheduling", func() {
 		expectErrorAtPath(testObj, "spec.rules[0].selector[0].or[0].and[0].not.or[0]")
 	})
 
+	It("should allow set-based and pattern matching terms", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Selector: []lssv1alpha1.Selector{
+					{MatchTenant: &lssv1alpha1.TenantSelector{IDs: []string{"test-tenant-1", "test-tenant-2"}}},
+					{MatchTenant: &lssv1alpha1.TenantSelector{Regex: "^test-tenant-[0-9]+$"}},
+					{MatchLabel: &lssv1alpha1.LabelSelector{Name: "region", Operator: lssv1alpha1.LabelSelectorOpIn, Values: []string{"eu", "us"}}},
+					{MatchLabel: &lssv1alpha1.LabelSelector{Name: "direction", Operator: lssv1alpha1.LabelSelectorOpDoesNotExist}},
+					{MatchPurpose: &lssv1alpha1.StringSelector{Prefix: "prod"}},
+					{MatchNamespace: &lssv1alpha1.StringSelector{Values: []string{"laas-tenant-a"}}},
+					{MatchHighAvailability: &lssv1alpha1.HighAvailabilitySelector{Enabled: true, ControlPlaneFailureTolerance: "zone"}},
+					{MatchDataPlane: &lssv1alpha1.DataPlaneSelector{Type: lssv1alpha1.LandscaperDeploymentDataPlaneTypeInternal}},
+				},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should deny invalid set-based and pattern matching terms", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Selector: []lssv1alpha1.Selector{
+					{MatchTenant: &lssv1alpha1.TenantSelector{ID: "test-tenant-1", Prefix: "test-"}},
+					{MatchLabel: &lssv1alpha1.LabelSelector{Name: "region", Operator: lssv1alpha1.LabelSelectorOpNotIn}},
+					{MatchPurpose: &lssv1alpha1.StringSelector{Regex: "prod("}},
+					{MatchHighAvailability: &lssv1alpha1.HighAvailabilitySelector{ControlPlaneFailureTolerance: "zone"}},
+					{MatchDataPlane: &lssv1alpha1.DataPlaneSelector{Type: "Hybrid"}},
+				},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.rules[0].selector[0].matchTenant"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.rules[0].selector[1].matchLabel.values"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.rules[0].selector[2].matchPurpose.regex"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.rules[0].selector[3].matchHighAvailability.controlPlaneFailureTolerance"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.rules[0].selector[4].matchDataPlane.type"))
+	})
+
+	It("should validate the scheduling constraints of a rule", func() {
+		testObj := createTargetScheduling("test", "lss-system")
+		testObj.Spec.Rules = []lssv1alpha1.SchedulingRule{
+			{
+				Priority: 10,
+				ServiceTargetConfigs: []lssv1alpha1.ObjectReference{
+					{Name: "test01", Namespace: "lss-system"},
+				},
+				Constraints: &lssv1alpha1.SchedulingConstraints{
+					SpreadConstraints: []lssv1alpha1.SpreadConstraint{
+						{TopologyKey: "topology.landscaper-service.gardener.cloud/region", MaxSkew: 2},
+					},
+					AntiAffinity: []lssv1alpha1.AntiAffinityTerm{
+						{LabelKey: "app", WhenUnsatisfiable: lssv1alpha1.ScheduleAnyway},
+					},
+				},
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		testObj.Spec.Rules[0].Constraints = &lssv1alpha1.SchedulingConstraints{
+			SpreadConstraints: []lssv1alpha1.SpreadConstraint{
+				{TopologyKey: "invalid key", MaxSkew: -1, WhenUnsatisfiable: "Sometimes"},
+			},
+			AntiAffinity: []lssv1alpha1.AntiAffinityTerm{
+				{},
+			},
+		}
+
+		request = CreateAdmissionRequest(testObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.rules[0].constraints.spreadConstraints[0].topologyKey"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.rules[0].constraints.spreadConstraints[0].maxSkew"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.rules[0].constraints.spreadConstraints[0].whenUnsatisfiable"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.rules[0].constraints.antiAffinity[0].labelKey"))
+	})
 })
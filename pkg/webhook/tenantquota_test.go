@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2024 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+
+	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
+	"github.com/gardener/landscaper-service/pkg/webhook"
+	"github.com/gardener/landscaper-service/test/utils/envtest"
+)
+
+func createTenantQuota(name, namespace string) *lssv1alpha1.TenantQuota {
+	quota := &lssv1alpha1.TenantQuota{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "TenantQuota",
+			APIVersion: lssv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	return quota
+}
+
+var _ = Describe("TenantQuota", func() {
+	var (
+		validator webhook.GenericValidator
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, webhook.TenantQuotasResourceType)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+	})
+
+	It("should allow valid resource", func() {
+		testObj := createTenantQuota("test", "lss-system")
+		testObj.Spec = lssv1alpha1.TenantQuotaSpec{
+			TenantId: "test0001",
+			Limits: lssv1alpha1.TenantQuotaResources{
+				Instances: ptr.To(2),
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should deny resource without tenant id", func() {
+		testObj := createTenantQuota("test", "lss-system")
+		testObj.Spec = lssv1alpha1.TenantQuotaSpec{
+			Limits: lssv1alpha1.TenantQuotaResources{
+				Instances: ptr.To(2),
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.tenantId"))
+	})
+
+	It("should deny resource with negative limits", func() {
+		testObj := createTenantQuota("test", "lss-system")
+		testObj.Spec = lssv1alpha1.TenantQuotaSpec{
+			TenantId: "test0001",
+			Limits: lssv1alpha1.TenantQuotaResources{
+				Instances:                 ptr.To(-1),
+				HighAvailabilityInstances: ptr.To(-1),
+			},
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("spec.limits.instances"))
+		Expect(response.Result.Message).To(ContainSubstring("spec.limits.highAvailabilityInstances"))
+	})
+})
+
+var _ = Describe("LandscaperDeployment TenantQuota", func() {
+	var (
+		validator webhook.GenericValidator
+		ctx       context.Context
+		state     *envtest.State
+	)
+
+	BeforeEach(func() {
+		var err error
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), testenv.Client, envtest.LandscaperServiceScheme, webhook.LandscaperDeploymentsResourceType)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+		state, err = testenv.InitResources(ctx, "./testdata/tenantquota")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		defer ctx.Done()
+		if state != nil {
+			Expect(testenv.CleanupResources(ctx, state)).ToNot(HaveOccurred())
+		}
+	})
+
+	newDeployment := func(namespace string) *lssv1alpha1.LandscaperDeployment {
+		testObj := createLandscaperDeployment("test", namespace)
+		testObj.Spec = lssv1alpha1.LandscaperDeploymentSpec{
+			TenantId: "quota001",
+			Purpose:  "test",
+			LandscaperConfiguration: lssv1alpha1.LandscaperConfiguration{
+				Deployers: []string{
+					"helm",
+				},
+				Resources: &lssv1alpha1.Resources{
+					Requests: lssv1alpha1.ResourceRequests{
+						CPU: "250m",
+					},
+				},
+			},
+		}
+		return testObj
+	}
+
+	It("should allow a deployment within the tenant quota", func() {
+		testObj := newDeployment(state.Namespace)
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should deny a deployment exceeding the high availability instances", func() {
+		testObj := newDeployment(state.Namespace)
+		testObj.Spec.HighAvailabilityConfig = &lssv1alpha1.HighAvailabilityConfig{
+			ControlPlaneFailureTolerance: "zone",
+		}
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("highAvailabilityInstances"))
+	})
+
+	It("should deny a deployment exceeding the requested cpu", func() {
+		testObj := newDeployment(state.Namespace)
+		testObj.Spec.LandscaperConfiguration.Resources.Requests.CPU = "1"
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("requestedCPU"))
+	})
+
+	It("should not enforce the tenant quota of other tenants", func() {
+		testObj := newDeployment(state.Namespace)
+		testObj.Spec.TenantId = "quota002"
+		testObj.Spec.LandscaperConfiguration.Resources.Requests.CPU = "4"
+
+		request := CreateAdmissionRequest(testObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should allow updates which don't increase the usage of an exceeded limit", func() {
+		quota := state.GetTenantQuota("test")
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(quota), quota)).To(Succeed())
+		quota.Spec.Limits.Instances = ptr.To(0)
+		Expect(testenv.Client.Update(ctx, quota)).To(Succeed())
+
+		existing := state.GetDeployment("existing")
+		existing.TypeMeta = metav1.TypeMeta{
+			Kind:       "LandscaperDeployment",
+			APIVersion: lssv1alpha1.SchemeGroupVersion.String(),
+		}
+		oldObj := existing.DeepCopyObject()
+		existing.Spec.LandscaperConfiguration.Deployers = []string{"manifest"}
+
+		request := CreateAdmissionRequestUpdate(existing, oldObj)
+		response := validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeTrue())
+
+		existing.Spec.LandscaperConfiguration.Resources.Requests.CPU = "1500m"
+		request = CreateAdmissionRequestUpdate(existing, oldObj)
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("requestedCPU"))
+
+		request = CreateAdmissionRequest(newDeployment(state.Namespace))
+		response = validator.Handle(ctx, request)
+		Expect(response).ToNot(BeNil())
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("instances"))
+	})
+})
@@ -5,10 +5,14 @@
 package webhook
 
 import (
+	"strings"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper-service/pkg/apis/core/install"
 )
 
 // GetCachelessClient is a helper function that returns a client that can be used before the manager is started
@@ -17,6 +21,31 @@ func GetCachelessClient(restConfig *rest.Config) (client.Client, error) {
 	if err := scheme.AddToScheme(s); err != nil {
 		return nil, err
 	}
+	// the landscaper service types are needed to validate landscaper deployments against the tenant quotas
+	install.Install(s)
 
 	return client.New(restConfig, client.Options{Scheme: s})
 }
+
+// FilterWebhookedResources returns a slice of WebhookedResourceDefinitions that contains only those of the given webhookedResources whose ResourceName is not specified in disabledWebhooks
+func FilterWebhookedResources(webhookedResources map[string]WebhookedResourceDefinition, disabledWebhooks map[string]bool) []WebhookedResourceDefinition {
+	fwr := []WebhookedResourceDefinition{}
+	if _, ok := disabledWebhooks["all"]; ok {
+		return fwr // all webhooks disabled, return empty slice
+	}
+	for _, wr := range webhookedResources {
+		if _, ok := disabledWebhooks[wr.ResourceName]; !ok {
+			fwr = append(fwr, wr)
+		}
+	}
+	return fwr
+}
+
+// StringListToMap turns a comma-separated list of strings into pseudo-set that maps all elements of the list to true
+func StringListToMap(opt string) map[string]bool {
+	res := map[string]bool{}
+	for _, t := range strings.Split(opt, ",") {
+		res[t] = true
+	}
+	return res
+}
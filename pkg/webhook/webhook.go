@@ -10,15 +10,21 @@ import (
 	"net/http"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 
 	lssv1alpha1 "github.com/gardener/landscaper-service/pkg/apis/core/v1alpha1"
 	"github.com/gardener/landscaper-service/pkg/apis/validation"
+	"github.com/gardener/landscaper-service/pkg/utils"
 )
 
 const (
@@ -26,6 +32,11 @@ const (
 	InstancesResourceType             = "instances"
 	ServiceTargetConfigsResourceType  = "servicetargetconfigs"
 	TargetSchedulingsResourceType     = "targetschedulings"
+	TenantQuotasResourceType          = "tenantquotas"
+	RebalancePlansResourceType        = "rebalanceplans"
+
+	NamespaceRegistrationsResourceType = "namespaceregistrations"
+	SubjectListsResourceType           = "subjectlists"
 )
 
 // ValidatorFromResourceType is a helper method that gets a resource type and returns the fitting validator
@@ -40,6 +51,14 @@ func ValidatorFromResourceType(log logging.Logger, kubeClient client.Client, sch
 		val = &ServiceTargetConfigValidator{abstrVal}
 	} else if resource == TargetSchedulingsResourceType {
 		val = &TargetSchedulingValidator{abstrVal}
+	} else if resource == TenantQuotasResourceType {
+		val = &TenantQuotaValidator{abstrVal}
+	} else if resource == RebalancePlansResourceType {
+		val = &RebalancePlanValidator{abstrVal}
+	} else if resource == NamespaceRegistrationsResourceType {
+		val = &NamespaceRegistrationValidator{abstrVal}
+	} else if resource == SubjectListsResourceType {
+		val = &SubjectListValidator{abstrVal}
 	} else {
 		return nil, fmt.Errorf("unable to find validator for resource type %q", resource)
 	}
@@ -47,9 +66,14 @@ func ValidatorFromResourceType(log logging.Logger, kubeClient client.Client, sch
 }
 
 type abstractValidator struct {
-	Client  client.Client
-	decoder runtime.Decoder
-	log     logging.Logger
+	Client            client.Client
+	decoder           runtime.Decoder
+	log               logging.Logger
+	supportedVersions []string
+	shootProfiles     []string
+	// shootKubernetesVersions maps the names of the shoot profiles to their kubernetes versions,
+	// the empty name maps to the kubernetes version of the default shoot configuration.
+	shootKubernetesVersions map[string]string
 }
 
 // newAbstractedValidator creates a new abstracted validator
@@ -64,6 +88,39 @@ func newAbstractedValidator(log logging.Logger, kubeClient client.Client, scheme
 // GenericValidator is an abstraction interface that implements admission.Handler and contains additional setter functions for the fields
 type GenericValidator interface {
 	Handle(context.Context, admission.Request) admission.Response
+	SetSupportedVersions(supportedVersions []string)
+	SetShootProfiles(shootProfiles []string)
+	SetShootKubernetesVersions(shootKubernetesVersions map[string]string)
+}
+
+// SetSupportedVersions sets the landscaper service component versions which can be selected.
+func (av *abstractValidator) SetSupportedVersions(supportedVersions []string) {
+	av.supportedVersions = supportedVersions
+}
+
+// SetShootProfiles sets the names of the shoot profiles which can be selected.
+func (av *abstractValidator) SetShootProfiles(shootProfiles []string) {
+	av.shootProfiles = shootProfiles
+}
+
+// SetShootKubernetesVersions sets the kubernetes versions of the shoot profiles.
+// The empty name sets the kubernetes version of the default shoot configuration.
+func (av *abstractValidator) SetShootKubernetesVersions(shootKubernetesVersions map[string]string) {
+	av.shootKubernetesVersions = shootKubernetesVersions
+}
+
+// validateShootProfileChange validates that a changed shoot profile doesn't lower the installed kubernetes version of the shoot cluster.
+// A shoot profile without kubernetes version uses the kubernetes version of the default shoot configuration.
+func (av *abstractValidator) validateShootProfileChange(shootProfile, oldShootProfile, installedVersion string, fldPath *field.Path) field.ErrorList {
+	if shootProfile == oldShootProfile {
+		return field.ErrorList{}
+	}
+
+	kubernetesVersion, ok := av.shootKubernetesVersions[shootProfile]
+	if !ok || len(kubernetesVersion) == 0 {
+		kubernetesVersion = av.shootKubernetesVersions[""]
+	}
+	return validation.ValidateShootProfileKubernetesVersion(kubernetesVersion, installedVersion, fldPath)
 }
 
 // LANDSCAPER DEPLOYMENT
@@ -72,7 +129,7 @@ type GenericValidator interface {
 type LandscaperDeploymentValidator struct{ abstractValidator }
 
 // Handle handles a request to the webhook
-func (dv *LandscaperDeploymentValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+func (dv *LandscaperDeploymentValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
 	deployment := &lssv1alpha1.LandscaperDeployment{}
 	if _, _, err := dv.decoder.Decode(req.Object.Raw, nil, deployment); err != nil {
 		return admission.Errored(http.StatusBadRequest, err)
@@ -86,20 +143,123 @@ func (dv *LandscaperDeploymentValidator) Handle(_ context.Context, req admission
 		}
 	}
 
-	if errs := validation.ValidateLandscaperDeployment(deployment, oldDeployment); len(errs) > 0 {
+	errs := validation.ValidateLandscaperDeployment(deployment, oldDeployment)
+	oldVersion := ""
+	oldShootProfile := ""
+	if oldDeployment != nil {
+		oldVersion = oldDeployment.Spec.Version
+		oldShootProfile = oldDeployment.Spec.ShootProfile
+	}
+	errs = append(errs, validation.ValidateVersion(deployment.Spec.Version, oldVersion, dv.supportedVersions, field.NewPath("spec", "version"))...)
+	errs = append(errs, validation.ValidateShootProfile(deployment.Spec.ShootProfile, oldShootProfile, dv.shootProfiles, field.NewPath("spec", "shootProfile"))...)
+	if len(errs) > 0 {
 		return admission.Denied(errs.ToAggregate().Error())
 	}
 
+	if oldDeployment != nil && deployment.Spec.ShootProfile != oldShootProfile {
+		installedVersion, err := dv.getInstalledKubernetesVersion(ctx, deployment)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if errs := dv.validateShootProfileChange(deployment.Spec.ShootProfile, oldShootProfile, installedVersion, field.NewPath("spec", "shootProfile")); len(errs) > 0 {
+			return admission.Denied(errs.ToAggregate().Error())
+		}
+	}
+
+	if deployment.DeletionTimestamp.IsZero() {
+		quotaErrs, err := dv.validateTenantQuotas(ctx, deployment, oldDeployment)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if len(quotaErrs) > 0 {
+			return admission.Denied(quotaErrs.ToAggregate().Error())
+		}
+	}
+
 	return admission.Allowed("LandscaperDeployment is valid")
 }
 
+// getInstalledKubernetesVersion returns the kubernetes version of the shoot cluster of the instance of a landscaper deployment.
+// It is empty if the instance has not been created yet.
+func (dv *LandscaperDeploymentValidator) getInstalledKubernetesVersion(ctx context.Context, deployment *lssv1alpha1.LandscaperDeployment) (string, error) {
+	if deployment.Status.InstanceRef == nil || deployment.Status.InstanceRef.IsEmpty() {
+		return "", nil
+	}
+
+	instance := &lssv1alpha1.Instance{}
+	if err := dv.Client.Get(ctx, deployment.Status.InstanceRef.NamespacedName(), instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("unable to get instance %s: %w", deployment.Status.InstanceRef.NamespacedName().String(), err)
+	}
+	return instance.Status.ShootKubernetesVersion, nil
+}
+
+// validateTenantQuotas validates that the landscaper deployment doesn't exceed the tenant quotas of its tenant.
+// The usage of the other landscaper deployments of the tenant is computed and the usage of the old and new landscaper deployment is added.
+// A limit is only violated, if it is exceeded by the new usage and the usage has increased compared to the old usage.
+// The limits are enforced best-effort: the usage is not reserved, so concurrent admissions of landscaper deployments
+// of the same tenant may each pass the check and exceed a limit together.
+func (dv *LandscaperDeploymentValidator) validateTenantQuotas(ctx context.Context, deployment, oldDeployment *lssv1alpha1.LandscaperDeployment) (field.ErrorList, error) {
+	allErrs := field.ErrorList{}
+
+	quotas, err := utils.ListTenantQuotas(ctx, dv.Client, deployment.Spec.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	if len(quotas) == 0 {
+		return allErrs, nil
+	}
+
+	deployments, err := utils.ListTenantLandscaperDeployments(ctx, dv.Client, deployment.Spec.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := utils.NewTenantQuotaUsage()
+	for i := range deployments {
+		other := &deployments[i]
+		if other.Namespace == deployment.Namespace && other.Name == deployment.Name {
+			continue
+		}
+		if err := utils.AddTenantQuotaUsage(&usage, other); err != nil {
+			dv.log.Info("Ignoring usage of landscaper deployment", lc.KeyError, err.Error())
+		}
+	}
+
+	var previousUsage *lssv1alpha1.TenantQuotaResources
+	if oldDeployment != nil {
+		previousUsage = usage.DeepCopy()
+		if err := utils.AddTenantQuotaUsage(previousUsage, oldDeployment); err != nil {
+			dv.log.Info("Ignoring usage of old landscaper deployment", lc.KeyError, err.Error())
+		}
+	}
+
+	fldPath := field.NewPath("spec", "landscaperConfiguration", "resources")
+	if err := utils.AddTenantQuotaUsage(&usage, deployment); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, deployment.Spec.LandscaperConfiguration.Resources, err.Error()))
+		return allErrs, nil
+	}
+
+	for i := range quotas {
+		quota := &quotas[i]
+		for _, exceeded := range utils.ExceededTenantQuotaLimits(&quota.Spec.Limits, &usage, previousUsage) {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "tenantId"),
+				fmt.Sprintf("tenant quota %s/%s is exceeded: %s", quota.Namespace, quota.Name, exceeded)))
+		}
+	}
+
+	return allErrs, nil
+}
+
 // INSTANCE
 
 // InstanceValidator represents a validator for an Instance
 type InstanceValidator struct{ abstractValidator }
 
 // Handle handles a request to the webhook
-func (iv *InstanceValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+func (iv *InstanceValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
 	instance := &lssv1alpha1.Instance{}
 	if _, _, err := iv.decoder.Decode(req.Object.Raw, nil, instance); err != nil {
 		return admission.Errored(http.StatusBadRequest, err)
@@ -113,13 +273,51 @@ func (iv *InstanceValidator) Handle(_ context.Context, req admission.Request) ad
 		}
 	}
 
-	if errs := validation.ValidateInstance(instance, oldInstance); len(errs) > 0 {
+	errs := validation.ValidateInstance(instance, oldInstance)
+	oldVersion := ""
+	oldShootProfile := ""
+	if oldInstance != nil {
+		oldVersion = oldInstance.Spec.Version
+		oldShootProfile = oldInstance.Spec.ShootProfile
+	}
+	errs = append(errs, validation.ValidateVersion(instance.Spec.Version, oldVersion, iv.supportedVersions, field.NewPath("spec", "version"))...)
+	errs = append(errs, validation.ValidateShootProfile(instance.Spec.ShootProfile, oldShootProfile, iv.shootProfiles, field.NewPath("spec", "shootProfile"))...)
+	if oldInstance != nil {
+		errs = append(errs, iv.validateShootProfileChange(instance.Spec.ShootProfile, oldShootProfile, oldInstance.Status.ShootKubernetesVersion, field.NewPath("spec", "shootProfile"))...)
+	}
+	if len(errs) > 0 {
 		return admission.Denied(errs.ToAggregate().Error())
 	}
 
+	if oldInstance != nil && !instance.Spec.ServiceTargetConfigRef.Equals(&oldInstance.Spec.ServiceTargetConfigRef) {
+		destinationErrs, err := iv.validateMigrationDestination(ctx, instance)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if len(destinationErrs) > 0 {
+			return admission.Denied(destinationErrs.ToAggregate().Error())
+		}
+	}
+
 	return admission.Allowed("Instance is valid")
 }
 
+// validateMigrationDestination validates that the destination service target config of a migration exists
+// and is available for the instance.
+func (iv *InstanceValidator) validateMigrationDestination(ctx context.Context, instance *lssv1alpha1.Instance) (field.ErrorList, error) {
+	fldPath := field.NewPath("spec", "serviceTargetConfigRef")
+
+	destination := &lssv1alpha1.ServiceTargetConfig{}
+	if err := iv.Client.Get(ctx, instance.Spec.ServiceTargetConfigRef.NamespacedName(), destination); err != nil {
+		if apierrors.IsNotFound(err) {
+			return field.ErrorList{field.NotFound(fldPath, instance.Spec.ServiceTargetConfigRef.NamespacedName().String())}, nil
+		}
+		return nil, fmt.Errorf("unable to get service target config %s: %w", instance.Spec.ServiceTargetConfigRef.NamespacedName().String(), err)
+	}
+
+	return validation.ValidateInstanceMigrationDestination(instance, destination, fldPath), nil
+}
+
 // SERVICE TARGET CONFIG
 
 // ServiceTargetConfigValidator represents a validator for a ServiceTargetConfig
@@ -156,3 +354,97 @@ func (sv *TargetSchedulingValidator) Handle(_ context.Context, req admission.Req
 
 	return admission.Allowed("TargetScheduling is valid")
 }
+
+// TENANT QUOTA
+
+// TenantQuotaValidator represents a validator for a TenantQuota
+type TenantQuotaValidator struct{ abstractValidator }
+
+// Handle handles a request to the webhook
+func (tv *TenantQuotaValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	quota := &lssv1alpha1.TenantQuota{}
+	if _, _, err := tv.decoder.Decode(req.Object.Raw, nil, quota); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateTenantQuota(quota); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("TenantQuota is valid")
+}
+
+// REBALANCE PLAN
+
+// RebalancePlanValidator represents a validator for a RebalancePlan
+type RebalancePlanValidator struct{ abstractValidator }
+
+// Handle handles a request to the webhook
+func (rv *RebalancePlanValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	plan := &lssv1alpha1.RebalancePlan{}
+	if _, _, err := rv.decoder.Decode(req.Object.Raw, nil, plan); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateRebalancePlan(plan); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("RebalancePlan is valid")
+}
+
+// NAMESPACE REGISTRATION
+
+// NamespaceRegistrationValidator represents a validator for a NamespaceRegistration
+type NamespaceRegistrationValidator struct{ abstractValidator }
+
+// Handle handles a request to the webhook
+func (nv *NamespaceRegistrationValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	namespaceRegistration := &lssv1alpha1.NamespaceRegistration{}
+	if _, _, err := nv.decoder.Decode(req.Object.Raw, nil, namespaceRegistration); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !namespaceRegistration.DeletionTimestamp.IsZero() {
+		return admission.Allowed("NamespaceRegistration is being deleted")
+	}
+
+	if errs := validation.ValidateNamespaceRegistration(namespaceRegistration); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	// a namespace registration must not take over a namespace, which has not been created for it
+	if req.Operation == admissionv1.Create {
+		namespace := &corev1.Namespace{}
+		err := nv.Client.Get(ctx, types.NamespacedName{Name: namespaceRegistration.GetName()}, namespace)
+		if err == nil {
+			errs := field.ErrorList{
+				field.Forbidden(field.NewPath("metadata", "name"), fmt.Sprintf("namespace %q already exists", namespaceRegistration.GetName())),
+			}
+			return admission.Denied(errs.ToAggregate().Error())
+		} else if !apierrors.IsNotFound(err) {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+	}
+
+	return admission.Allowed("NamespaceRegistration is valid")
+}
+
+// SUBJECT LIST
+
+// SubjectListValidator represents a validator for a SubjectList
+type SubjectListValidator struct{ abstractValidator }
+
+// Handle handles a request to the webhook
+func (sv *SubjectListValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	subjectList := &lssv1alpha1.SubjectList{}
+	if _, _, err := sv.decoder.Decode(req.Object.Raw, nil, subjectList); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateSubjectList(subjectList); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("SubjectList is valid")
+}
@@ -62,6 +62,14 @@ func DefaultControllerConfiguration() *config.LandscaperServiceConfiguration {
 			PeriodicCheckInterval:           v1alpha1.Duration{Duration: time.Minute * 1},
 			LSHealthCheckTimeout:            v1alpha1.Duration{Duration: time.Minute * 5},
 		},
+		Upgrade: config.UpgradeConfiguration{
+			MaxConcurrentUpgrades: 1,
+			CheckInterval:         v1alpha1.Duration{Duration: time.Minute * 1},
+		},
+		CredentialRotation: config.CredentialRotationConfiguration{
+			TokenExpiration:           v1alpha1.Duration{Duration: time.Hour * 24 * 90},
+			AdminKubeconfigExpiration: v1alpha1.Duration{Duration: time.Hour * 24},
+		},
 		GardenerConfiguration: config.GardenerConfiguration{
 			ShootSecretBindingName: "secret-binding",
 			ProjectName:            "test",
@@ -203,6 +203,21 @@ func (e *Environment) CleanupResources(ctx context.Context, state *State) error
 			return err
 		}
 	}
+	for _, obj := range state.TenantQuotas {
+		if err := e.deleteObject(ctx, obj); err != nil {
+			return err
+		}
+	}
+	for _, obj := range state.TargetSchedulings {
+		if err := e.deleteObject(ctx, obj); err != nil {
+			return err
+		}
+	}
+	for _, obj := range state.RebalancePlans {
+		if err := e.deleteObject(ctx, obj); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -456,6 +471,24 @@ func (e *Environment) decodeAndAppendLSSObject(data []byte, objects []client.Obj
 			return nil, fmt.Errorf("unable to decode file as  SubjectList: %w", err)
 		}
 		return append(objects, subjectList), nil
+	case TenantQuotaGVK.Kind:
+		quota := &lssv1alpha1.TenantQuota{}
+		if _, _, err := decoder.Decode(data, nil, quota); err != nil {
+			return nil, fmt.Errorf("unable to decode file as tenant quota: %w", err)
+		}
+		return append(objects, quota), nil
+	case TargetSchedulingGVK.Kind:
+		scheduling := &lssv1alpha1.TargetScheduling{}
+		if _, _, err := decoder.Decode(data, nil, scheduling); err != nil {
+			return nil, fmt.Errorf("unable to decode file as target scheduling: %w", err)
+		}
+		return append(objects, scheduling), nil
+	case RebalancePlanGVK.Kind:
+		plan := &lssv1alpha1.RebalancePlan{}
+		if _, _, err := decoder.Decode(data, nil, plan); err != nil {
+			return nil, fmt.Errorf("unable to decode file as rebalance plan: %w", err)
+		}
+		return append(objects, plan), nil
 
 	default:
 		return objects, nil
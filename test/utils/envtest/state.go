@@ -50,6 +50,12 @@ type State struct {
 	NamespaceRegistrations map[string]*lssv1alpha1.NamespaceRegistration
 	// SubjectLists contains all SubjectList in this test environment
 	SubjectLists map[string]*lssv1alpha1.SubjectList
+	// TenantQuotas contains all TenantQuota in this test environment
+	TenantQuotas map[string]*lssv1alpha1.TenantQuota
+	// TargetSchedulings contains all TargetScheduling in this test environment
+	TargetSchedulings map[string]*lssv1alpha1.TargetScheduling
+	// RebalancePlans contains all RebalancePlan in this test environment
+	RebalancePlans map[string]*lssv1alpha1.RebalancePlan
 }
 
 // NewState creates a new state.
@@ -71,6 +77,9 @@ func NewState(namespace string) *State {
 		LsHealthChecks:          make(map[string]*lsv1alpha1.LsHealthCheck),
 		NamespaceRegistrations:  make(map[string]*lssv1alpha1.NamespaceRegistration),
 		SubjectLists:            make(map[string]*lssv1alpha1.SubjectList),
+		TenantQuotas:            make(map[string]*lssv1alpha1.TenantQuota),
+		TargetSchedulings:       make(map[string]*lssv1alpha1.TargetScheduling),
+		RebalancePlans:          make(map[string]*lssv1alpha1.RebalancePlan),
 	}
 }
 
@@ -151,6 +160,21 @@ func (s *State) GetSubjectList(name string) *lssv1alpha1.SubjectList {
 	return s.SubjectLists[subjectsync.LS_USER_NAMESPACE+"/"+name]
 }
 
+// GetTenantQuota retrieves a TenantQuota by the given name
+func (s *State) GetTenantQuota(name string) *lssv1alpha1.TenantQuota {
+	return s.TenantQuotas[s.Namespace+"/"+name]
+}
+
+// GetTargetScheduling retrieves a TargetScheduling by the given name
+func (s *State) GetTargetScheduling(name string) *lssv1alpha1.TargetScheduling {
+	return s.TargetSchedulings[s.Namespace+"/"+name]
+}
+
+// GetRebalancePlan retrieves a RebalancePlan by the given name
+func (s *State) GetRebalancePlan(name string) *lssv1alpha1.RebalancePlan {
+	return s.RebalancePlans[s.Namespace+"/"+name]
+}
+
 // AddObject adds a client.Object to the state.
 func (s *State) AddObject(object client.Object) {
 	switch o := object.(type) {
@@ -190,5 +214,11 @@ func (s *State) AddObject(object client.Object) {
 		s.NamespaceRegistrations[types.NamespacedName{Name: o.Name, Namespace: o.Namespace}.String()] = o.DeepCopy()
 	case *lssv1alpha1.SubjectList:
 		s.SubjectLists[types.NamespacedName{Name: o.Name, Namespace: o.Namespace}.String()] = o.DeepCopy()
+	case *lssv1alpha1.TenantQuota:
+		s.TenantQuotas[types.NamespacedName{Name: o.Name, Namespace: o.Namespace}.String()] = o.DeepCopy()
+	case *lssv1alpha1.TargetScheduling:
+		s.TargetSchedulings[types.NamespacedName{Name: o.Name, Namespace: o.Namespace}.String()] = o.DeepCopy()
+	case *lssv1alpha1.RebalancePlan:
+		s.RebalancePlans[types.NamespacedName{Name: o.Name, Namespace: o.Namespace}.String()] = o.DeepCopy()
 	}
 }
@@ -52,6 +52,12 @@ var (
 	NamespaceRegistrationGVK schema.GroupVersionKind
 	// SubjectListGVK is the GVK for SubjectList.
 	SubjectListGVK schema.GroupVersionKind
+	// TenantQuotaGVK is the GVK for TenantQuota.
+	TenantQuotaGVK schema.GroupVersionKind
+	// TargetSchedulingGVK is the GVK for TargetScheduling.
+	TargetSchedulingGVK schema.GroupVersionKind
+	// RebalancePlanGVK is the GVK for RebalancePlan.
+	RebalancePlanGVK schema.GroupVersionKind
 )
 
 func init() {
@@ -91,4 +97,10 @@ func init() {
 	utilruntime.Must(err)
 	SubjectListGVK, err = apiutil.GVKForObject(&lssv1alpha1.SubjectList{}, LandscaperServiceScheme)
 	utilruntime.Must(err)
+	TenantQuotaGVK, err = apiutil.GVKForObject(&lssv1alpha1.TenantQuota{}, LandscaperServiceScheme)
+	utilruntime.Must(err)
+	TargetSchedulingGVK, err = apiutil.GVKForObject(&lssv1alpha1.TargetScheduling{}, LandscaperServiceScheme)
+	utilruntime.Must(err)
+	RebalancePlanGVK, err = apiutil.GVKForObject(&lssv1alpha1.RebalancePlan{}, LandscaperServiceScheme)
+	utilruntime.Must(err)
 }